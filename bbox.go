@@ -0,0 +1,132 @@
+package svg
+
+import (
+	"fmt"
+	"math"
+)
+
+// BBox represents an axis aligned bounding box
+// Use EmptyBBox as the starting point of unions, the zero value is a box of a single point at the origin.
+type BBox struct {
+	MinX float64
+	MinY float64
+	MaxX float64
+	MaxY float64
+}
+
+// EmptyBBox returns a BBox which contains no points at all
+func EmptyBBox() BBox {
+	return BBox{MinX: math.Inf(1), MinY: math.Inf(1), MaxX: math.Inf(-1), MaxY: math.Inf(-1)}
+}
+
+// BBoxOf constructs a BBox from its top left corner and its size
+func BBoxOf(x, y, width, height float64) BBox {
+	return BBox{MinX: x, MinY: y, MaxX: x + width, MaxY: y + height}.normalize()
+}
+
+func (b BBox) normalize() BBox {
+	if b.MinX > b.MaxX {
+		b.MinX, b.MaxX = b.MaxX, b.MinX
+	}
+	if b.MinY > b.MaxY {
+		b.MinY, b.MaxY = b.MaxY, b.MinY
+	}
+
+	return b
+}
+
+// IsEmpty reports whether a BBox contains no points at all
+func (b BBox) IsEmpty() bool {
+	return b.MinX > b.MaxX || b.MinY > b.MaxY
+}
+
+// Width returns the width of a BBox, which is 0 for an empty BBox
+func (b BBox) Width() float64 {
+	if b.IsEmpty() {
+		return 0
+	}
+
+	return b.MaxX - b.MinX
+}
+
+// Height returns the height of a BBox, which is 0 for an empty BBox
+func (b BBox) Height() float64 {
+	if b.IsEmpty() {
+		return 0
+	}
+
+	return b.MaxY - b.MinY
+}
+
+// AddPoint returns the smallest BBox containing both b and the point (x, y)
+func (b BBox) AddPoint(x, y float64) BBox {
+	return BBox{
+		MinX: math.Min(b.MinX, x),
+		MinY: math.Min(b.MinY, y),
+		MaxX: math.Max(b.MaxX, x),
+		MaxY: math.Max(b.MaxY, y),
+	}
+}
+
+// Union returns the smallest BBox containing both b and other
+func (b BBox) Union(other BBox) BBox {
+	if other.IsEmpty() {
+		return b
+	}
+	if b.IsEmpty() {
+		return other
+	}
+
+	return b.AddPoint(other.MinX, other.MinY).AddPoint(other.MaxX, other.MaxY)
+}
+
+// Expand returns a BBox grown by dx on the left and right and by dy on the top and bottom
+// Negative values shrink the BBox. An empty BBox stays empty.
+func (b BBox) Expand(dx, dy float64) BBox {
+	if b.IsEmpty() {
+		return b
+	}
+
+	return BBox{MinX: b.MinX - dx, MinY: b.MinY - dy, MaxX: b.MaxX + dx, MaxY: b.MaxY + dy}
+}
+
+// Transform returns the BBox of the corners of b transformed by m
+func (b BBox) Transform(m Matrix) BBox {
+	if b.IsEmpty() {
+		return b
+	}
+
+	res := EmptyBBox()
+	for _, p := range [4][2]float64{{b.MinX, b.MinY}, {b.MaxX, b.MinY}, {b.MaxX, b.MaxY}, {b.MinX, b.MaxY}} {
+		res = res.AddPoint(m.Apply(p[0], p[1]))
+	}
+
+	return res
+}
+
+// Contains reports whether the point (x, y) is inside of a BBox, points on the edges are inside
+func (b BBox) Contains(x, y float64) bool {
+	return x >= b.MinX && x <= b.MaxX && y >= b.MinY && y <= b.MaxY
+}
+
+// Intersects reports whether two boxes overlap, touching edges count as overlapping
+func (b BBox) Intersects(other BBox) bool {
+	if b.IsEmpty() || other.IsEmpty() {
+		return false
+	}
+
+	return b.MinX <= other.MaxX && other.MinX <= b.MaxX && b.MinY <= other.MaxY && other.MinY <= b.MaxY
+}
+
+// ViewBox returns a ViewBox covering a BBox
+func (b BBox) ViewBox() ViewBox {
+	return VB(b.MinX, b.MinY, b.Width(), b.Height())
+}
+
+func (b BBox) String() string {
+	if b.IsEmpty() {
+		return "empty"
+	}
+
+	return fmt.Sprintf("%v,%v %v,%v", b.MinX, b.MinY, b.MaxX, b.MaxY)
+}
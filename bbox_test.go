@@ -0,0 +1,62 @@
+package svg
+
+import (
+	"testing"
+)
+
+func TestBBox(t *testing.T) {
+	b := EmptyBBox()
+	if !b.IsEmpty() || b.Width() != 0 || b.Height() != 0 {
+		t.Errorf("EmptyBBox() = %v, want empty", b)
+	}
+
+	b = b.AddPoint(10, 20).Union(BBoxOf(30, 40, -10, 10))
+	if b != (BBox{MinX: 10, MinY: 20, MaxX: 30, MaxY: 50}) {
+		t.Errorf("Union() = %v", b)
+	}
+	if b.Width() != 20 || b.Height() != 30 {
+		t.Errorf("size = %v x %v, want 20 x 30", b.Width(), b.Height())
+	}
+	if got := b.Union(EmptyBBox()); got != b {
+		t.Errorf("Union() with empty = %v, want %v", got, b)
+	}
+	if got := b.Expand(1, 2); got != (BBox{MinX: 9, MinY: 18, MaxX: 31, MaxY: 52}) {
+		t.Errorf("Expand() = %v", got)
+	}
+	if got := EmptyBBox().Expand(1, 1); !got.IsEmpty() {
+		t.Errorf("Expand() of empty = %v, want empty", got)
+	}
+	if got := b.Transform(ScaleMatrix(-1, 2)); got != (BBox{MinX: -30, MinY: 40, MaxX: -10, MaxY: 100}) {
+		t.Errorf("Transform() = %v", got)
+	}
+	if got := b.ViewBox(); got != VB(10, 20, 20, 30) {
+		t.Errorf("ViewBox() = %v", got)
+	}
+}
+
+func TestBBox_Contains(t *testing.T) {
+	b := BBoxOf(0, 0, 10, 5)
+
+	if !b.Contains(0, 5) || !b.Contains(3, 3) || b.Contains(11, 3) || EmptyBBox().Contains(0, 0) {
+		t.Errorf("Contains() is wrong for %v", b)
+	}
+
+	tests := []struct {
+		name  string
+		other BBox
+		want  bool
+	}{
+		{"overlapping", BBoxOf(5, 2, 10, 10), true},
+		{"touching", BBoxOf(10, 5, 1, 1), true},
+		{"inside", BBoxOf(1, 1, 1, 1), true},
+		{"apart", BBoxOf(11, 0, 1, 1), false},
+		{"empty", EmptyBBox(), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := b.Intersects(tt.other); got != tt.want {
+				t.Errorf("Intersects() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
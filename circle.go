@@ -8,19 +8,15 @@ import (
 // Circle represents a Circle SVG element
 // See: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/circle
 type Circle struct {
-	XMLName       xml.Name
-	CX            *Length    `xml:"cx,attr,omitempty"`
-	CY            *Length    `xml:"cy,attr,omitempty"`
-	R             *Length    `xml:"r,attr,omitempty"`
-	Stroke        *Color     `xml:"stroke,attr,omitempty"`
-	StrokeWidth   *uint8     `xml:"stroke-width,attr,omitempty"`
-	StrokeOpacity *Opacity   `xml:"stroke-opacity,attr,omitempty"`
-	Fill          *Color     `xml:"fill,attr,omitempty"`
-	FillOpacity   *Opacity   `xml:"fill-opacity,attr,omitempty"`
-	Opacity       float64    `xml:"opacity,attr,omitempty"`
-	Attrs         []xml.Attr `xml:",attr"`
-	Children      []interface{}
-	lock          *sync.Mutex
+	XMLName   xml.Name
+	CX        *LengthExpr `xml:"cx,attr,omitempty"`
+	CY        *LengthExpr `xml:"cy,attr,omitempty"`
+	R         *LengthExpr `xml:"r,attr,omitempty"`
+	Transform *Transform  `xml:"transform,attr,omitempty"`
+	Presentation
+	Attrs    []xml.Attr `xml:",attr"`
+	Children []interface{}
+	lock     *sync.Mutex
 }
 
 // C constructs new Circle element (shortcut)
@@ -53,9 +49,9 @@ func C(cx, cy, r float64, children ...interface{}) Circle {
 func NewCircle(cx, cy, r *Length, children ...interface{}) Circle {
 	c := Circle{
 		XMLName: xml.Name{Local: "circle"},
-		CX:      cx,
-		CY:      cy,
-		R:       r,
+		CX:      exprOf(cx),
+		CY:      exprOf(cy),
+		R:       exprOf(r),
 		lock:    &sync.Mutex{},
 	}
 
@@ -64,85 +60,16 @@ func NewCircle(cx, cy, r *Length, children ...interface{}) Circle {
 	return c
 }
 
-// SetStrokeWidth sets the stroke width of a Circle
-func (c Circle) SetStrokeWidth(strokeWidth uint8) Circle {
-	c.StrokeWidth = &strokeWidth
+// SetTransform sets the transform of a Circle
+func (c Circle) SetTransform(transform Transform) Circle {
+	c.Transform = &transform
 
 	return c
 }
 
-// UnsetStrokeWidth removes the previously set stroke width of a Circle
-func (c Circle) UnsetStrokeWidth() Circle {
-	c.StrokeWidth = nil
-
-	return c
-}
-
-// SetStroke sets the stroke color of a Circle
-func (c Circle) SetStroke(stroke Color) Circle {
-	c.Stroke = &stroke
-
-	return c
-}
-
-// UnsetStroke removes the previously set stroke color of a Circle
-func (c Circle) UnsetStroke() Circle {
-	c.Stroke = nil
-
-	return c
-}
-
-// SetStrokeOpacity sets the stroke opacity of a Circle
-func (c Circle) SetStrokeOpacity(so Opacity) Circle {
-	c.StrokeOpacity = &so
-
-	return c
-}
-
-// SetStrokeOpacity removes the stroke opacity of a Circle
-func (c Circle) UnsetStrokeOpacity() Circle {
-	c.StrokeOpacity = nil
-
-	return c
-}
-
-// SetStroke sets the fill color of a Circle
-func (c Circle) SetFill(fill Color) Circle {
-	c.Fill = &fill
-
-	return c
-}
-
-// UnsetStroke removes the previously set fill color of a Circle
-func (c Circle) UnsetFill() Circle {
-	c.Fill = nil
-
-	return c
-}
-
-// SetStrokeOpacity sets the fill opacity of a Circle
-func (c Circle) SetFillOpacity(fo Opacity) Circle {
-	c.FillOpacity = &fo
-
-	return c
-}
-
-// SetStrokeOpacity removes the stroke opacity of a Circle
-func (c Circle) UnsetFillOpacity() Circle {
-	c.FillOpacity = nil
-
-	return c
-}
-
-// SetOpacity sets the opacity of a Circle
-func (c Circle) SetOpacity(o float64) Circle {
-	if o < 0 {
-		c.Opacity = 0
-	} else if o > 1 {
-		c.Opacity = 1
-	} else {
-		c.Opacity = o
-	}
+// UnsetTransform removes the previously set transform of a Circle
+func (c Circle) UnsetTransform() Circle {
+	c.Transform = nil
 
 	return c
 }
@@ -170,3 +97,14 @@ func (c Circle) RemoveAttr(name string) Circle {
 
 	return c
 }
+
+// BBox returns the bounding box of the fill area of a Circle, without its transform
+// Percentages are resolved against an empty viewport, use Geometry for other viewports.
+func (c Circle) BBox() BBox {
+	return defaultGeometry.BBox(c)
+}
+
+// StrokeBBox returns the bounding box of a Circle including its stroke, without its transform
+func (c Circle) StrokeBBox() BBox {
+	return defaultGeometry.StrokeBBox(c)
+}
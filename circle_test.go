@@ -23,7 +23,7 @@ func TestC(t *testing.T) {
 		{
 			"simple circle",
 			args{cx: 1, cy: 2, r: 4.2},
-			Circle{XMLName: xml.Name{Local: "circle"}, CX: &Length{Number: 1}, CY: &Length{Number: 2}, R: &Length{Number: 4.2}, lock: &sync.Mutex{}},
+			Circle{XMLName: xml.Name{Local: "circle"}, CX: exprOf(&Length{Number: 1}), CY: exprOf(&Length{Number: 2}), R: exprOf(&Length{Number: 4.2}), lock: &sync.Mutex{}},
 		},
 	}
 	for _, tt := range tests {
@@ -50,7 +50,7 @@ func TestNewCircle(t *testing.T) {
 		{
 			"simple circle",
 			args{cx: &Length{Number: 1}, cy: &Length{Number: 2}, r: &Length{Number: 4.2}},
-			Circle{XMLName: xml.Name{Local: "circle"}, CX: &Length{Number: 1}, CY: &Length{Number: 2}, R: &Length{Number: 4.2}, lock: &sync.Mutex{}},
+			Circle{XMLName: xml.Name{Local: "circle"}, CX: exprOf(&Length{Number: 1}), CY: exprOf(&Length{Number: 2}), R: exprOf(&Length{Number: 4.2}), lock: &sync.Mutex{}},
 		},
 	}
 	for _, tt := range tests {
@@ -81,6 +81,30 @@ func TestCircle_MarshalText(t *testing.T) {
 			[]string{`<circle cy="100" r="50"></circle>`},
 			false,
 		},
+		{
+			"fractional stroke width",
+			C(0, 100, 50).SStrokeWidth(0.5),
+			[]string{`<circle cy="100" r="50" stroke-width="0.5"></circle>`},
+			false,
+		},
+		{
+			"stroke width with unit",
+			C(0, 100, 50).SetStrokeWidth(Lth(1.25, Percent)),
+			[]string{`<circle cy="100" r="50" stroke-width="1.25%"></circle>`},
+			false,
+		},
+		{
+			"stroke width in px",
+			C(0, 100, 50).SetStrokeWidth(Lth(1.25, Px)),
+			[]string{`<circle cy="100" r="50" stroke-width="1.25px"></circle>`},
+			false,
+		},
+		{
+			"stroke width removed",
+			C(0, 100, 50).SStrokeWidth(2).UnsetStrokeWidth(),
+			[]string{`<circle cy="100" r="50"></circle>`},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -4,19 +4,81 @@ import (
 	"errors"
 	"fmt"
 	"image/color"
+	"math"
+	"strconv"
 	"strings"
 )
 
+// Color represents an sRGB color
+// Unlike the usual convention of image/color, the channels are not premultiplied by alpha,
+// so Color{color.RGBA{255, 0, 0, 128}} is a half transparent red.
 type Color struct {
 	color.RGBA
 }
 
+// ColorFormat defines how colors are written
+type ColorFormat int
+
+const (
+	// ColorHex writes colors as #rrggbb, the alpha channel is dropped
+	ColorHex ColorFormat = iota
+	// ColorHexAlpha writes colors as #rrggbb, or as #rrggbbaa if they are not opaque
+	ColorHexAlpha
+	// ColorRGBA writes colors as #rrggbb, or as rgba(r, g, b, a) if they are not opaque
+	ColorRGBA
+	// ColorKeyword writes colors which exactly match a color name as the name, e.g. "red" instead of
+	// "#ff0000", other colors are written as #rrggbb
+	ColorKeyword
+)
+
+// String returns a Color as #rrggbb, the alpha channel is dropped
+// Use Format for other formats, Paint.SetFormat to marshal fill and stroke colors in another format, or
+// Color.Opacity to write the alpha channel into a separate opacity attribute.
 func (c Color) String() string {
-	return fmt.Sprintf("#%s%s%s", twoDigitHexa(c.R), twoDigitHexa(c.G), twoDigitHexa(c.B))
+	return c.Format(ColorHex)
 }
 
+// Format returns the string representation of a Color in the given format
+func (c Color) Format(f ColorFormat) string {
+	hexa := fmt.Sprintf("#%s%s%s", twoDigitHexa(c.R), twoDigitHexa(c.G), twoDigitHexa(c.B))
+
+	if f == ColorKeyword {
+		if cn, ok := c.Name(); ok {
+			return string(cn)
+		}
+	}
+
+	if c.A == 255 {
+		return hexa
+	}
+
+	switch f {
+	case ColorHexAlpha:
+		return hexa + twoDigitHexa(c.A)
+	case ColorRGBA:
+		a := strconv.FormatFloat(math.Round(float64(c.A)/255*1000)/1000, 'f', -1, 64)
+
+		return fmt.Sprintf("rgba(%d, %d, %d, %s)", c.R, c.G, c.B, a)
+	}
+
+	return hexa
+}
+
+// Opacity returns the alpha channel of a Color as Opacity, e.g. for a fill-opacity attribute
+func (c Color) Opacity() Opacity {
+	return Opacity{Number: math.Round(float64(c.A)/255*1000) / 1000}
+}
+
+// Opaque returns a Color with the alpha channel set to fully opaque
+func (c Color) Opaque() Color {
+	c.A = 255
+
+	return c
+}
+
+// UnmarshalText parses a color name, a hexadecimal color or an rgb(), rgba(), hsl() or hsla() function
 func (c *Color) UnmarshalText(text []byte) error {
-	t := string(text)
+	t := strings.TrimSpace(string(text))
 
 	newColorName, err := NewColorName(t)
 	if err == nil {
@@ -25,7 +87,15 @@ func (c *Color) UnmarshalText(text []byte) error {
 		return nil
 	}
 
-	newColor, err := ColorFromHexaString(t)
+	var newColor Color
+	switch {
+	case strings.HasPrefix(t, "#"):
+		newColor, err = ColorFromHexaString(t)
+	case strings.Contains(t, "("):
+		newColor, err = ColorFromCSSFunction(t)
+	default:
+		err = fmt.Errorf("invalid color: %s", t)
+	}
 	if err != nil {
 		return err
 	}
@@ -35,8 +105,49 @@ func (c *Color) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// alphaFormat returns the format keeping the alpha channel of a Color parsed from text
+// Hexadecimal colors stay hexadecimal, others are written as rgba(), opaque colors are written as #rrggbb.
+func alphaFormat(c Color, text string) ColorFormat {
+	switch {
+	case c.A == 255:
+		return ColorHex
+	case strings.HasPrefix(strings.TrimSpace(text), "#"):
+		return ColorHexAlpha
+	}
+
+	return ColorRGBA
+}
+
+// FormattedColor is a Color together with the format it is written in, e.g. for the stop-color attribute
+// Colors parsed with UnmarshalText keep their alpha channel when they are written again.
+type FormattedColor struct {
+	Color
+	Format ColorFormat
+}
+
+func (fc FormattedColor) String() string {
+	return fc.Color.Format(fc.Format)
+}
+
+func (fc FormattedColor) MarshalText() ([]byte, error) {
+	return []byte(fc.String()), nil
+}
+
+// UnmarshalText parses a color like Color.UnmarshalText does and sets a format keeping its alpha channel
+func (fc *FormattedColor) UnmarshalText(text []byte) error {
+	var c Color
+	if err := c.UnmarshalText(text); err != nil {
+		return err
+	}
+
+	*fc = FormattedColor{Color: c, Format: alphaFormat(c, string(text))}
+
+	return nil
+}
+
+// ColorFromHexaString parses a color in #rgb, #rgba, #rrggbb or #rrggbbaa format
 func ColorFromHexaString(s string) (Color, error) {
-	if len(s) != 4 && len(s) != 7 {
+	if len(s) != 4 && len(s) != 5 && len(s) != 7 && len(s) != 9 {
 		return Color{}, errors.New("invalid hexa color length")
 	}
 
@@ -44,12 +155,25 @@ func ColorFromHexaString(s string) (Color, error) {
 		return Color{}, errors.New("invalid first character for hexa color")
 	}
 
-	us, err := charsToUint8(s[1:])
+	digits, alpha := s[1:], "ff"
+	switch len(s) {
+	case 5:
+		digits, alpha = s[1:4], s[4:5]+s[4:5]
+	case 9:
+		digits, alpha = s[1:7], s[7:9]
+	}
+
+	us, err := charsToUint8(digits)
 	if err != nil {
 		return Color{}, err
 	}
 
-	return Color{RGBA: color.RGBA{R: us[0], G: us[1], B: us[2], A: 255}}, nil
+	a, err := strconv.ParseUint(alpha, 16, 8)
+	if err != nil {
+		return Color{}, errors.New("invalid hexa color alpha")
+	}
+
+	return Color{RGBA: color.RGBA{R: us[0], G: us[1], B: us[2], A: uint8(a)}}, nil
 }
 
 func (c *Color) MarshalText() ([]byte, error) {
@@ -57,7 +181,7 @@ func (c *Color) MarshalText() ([]byte, error) {
 		return []byte{}, nil
 	}
 
-	s := fmt.Sprintf("#%s%s%s", twoDigitHexa(c.R), twoDigitHexa(c.G), twoDigitHexa(c.B))
+	s := c.String()
 
 	return []byte(s), nil
 }
@@ -0,0 +1,223 @@
+package svg
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ColorFromCSSFunction parses a color in rgb(), rgba(), hsl() or hsla() functional notation
+// Both the legacy comma separated syntax, e.g. rgba(255, 0, 0, 50%), and the space separated
+// syntax of CSS Color 4, e.g. rgb(255 0 0 / 0.5), are supported. Out of range values are clamped.
+// See: https://www.w3.org/TR/css-color-4/#rgb-functions
+func ColorFromCSSFunction(s string) (Color, error) {
+	t := strings.ToLower(strings.TrimSpace(s))
+
+	open := strings.IndexByte(t, '(')
+	if open < 0 || !strings.HasSuffix(t, ")") {
+		return Color{}, fmt.Errorf("invalid color function: %s", s)
+	}
+
+	name := strings.TrimSpace(t[:open])
+
+	args, err := splitColorArgs(t[open+1 : len(t)-1])
+	if err != nil {
+		return Color{}, fmt.Errorf("invalid color function %s: %w", s, err)
+	}
+
+	var res Color
+
+	switch name {
+	case "rgb", "rgba":
+		res, err = rgbFromArgs(args)
+	case "hsl", "hsla":
+		res, err = hslFromArgs(args)
+	default:
+		return Color{}, fmt.Errorf("unknown color function: %s", name)
+	}
+	if err != nil {
+		return Color{}, fmt.Errorf("invalid color function %s: %w", s, err)
+	}
+
+	return res, nil
+}
+
+// splitColorArgs splits the arguments of a color function, the alpha value, if any, is always the fourth
+func splitColorArgs(s string) ([]string, error) {
+	if strings.Contains(s, ",") {
+		if strings.Contains(s, "/") {
+			return nil, errors.New("mixed comma and slash separators")
+		}
+
+		args := strings.Split(s, ",")
+		for i := range args {
+			args[i] = strings.TrimSpace(args[i])
+			if args[i] == "" || strings.ContainsAny(args[i], " \t\n\r") {
+				return nil, errors.New("invalid argument list")
+			}
+		}
+
+		if len(args) != 3 && len(args) != 4 {
+			return nil, fmt.Errorf("expected 3 or 4 arguments, got %d", len(args))
+		}
+
+		return args, nil
+	}
+
+	main, alpha := s, ""
+	if i := strings.IndexByte(s, '/'); i >= 0 {
+		main, alpha = s[:i], strings.TrimSpace(s[i+1:])
+		if alpha == "" || strings.ContainsAny(alpha, "/ \t\n\r") {
+			return nil, errors.New("invalid alpha value")
+		}
+	}
+
+	args := strings.Fields(main)
+	if len(args) != 3 {
+		return nil, fmt.Errorf("expected 3 arguments, got %d", len(args))
+	}
+
+	if alpha != "" {
+		args = append(args, alpha)
+	}
+
+	return args, nil
+}
+
+func rgbFromArgs(args []string) (Color, error) {
+	var channels [3]uint8
+
+	for i := 0; i < 3; i++ {
+		v, percent, err := parseColorNumber(args[i])
+		if err != nil {
+			return Color{}, err
+		}
+
+		if percent {
+			v = v * 255 / 100
+		}
+
+		channels[i] = clampToUint8(v)
+	}
+
+	a, err := alphaFromArgs(args)
+	if err != nil {
+		return Color{}, err
+	}
+
+	return Color{RGBA: color.RGBA{R: channels[0], G: channels[1], B: channels[2], A: a}}, nil
+}
+
+func hslFromArgs(args []string) (Color, error) {
+	h, err := parseHue(args[0])
+	if err != nil {
+		return Color{}, err
+	}
+
+	var sl [2]float64
+	for i := 0; i < 2; i++ {
+		v, _, err := parseColorNumber(args[i+1])
+		if err != nil {
+			return Color{}, err
+		}
+		sl[i] = math.Max(0, math.Min(100, v)) / 100
+	}
+
+	a, err := alphaFromArgs(args)
+	if err != nil {
+		return Color{}, err
+	}
+
+	res := colorFromFloats(hslToRGB(h, sl[0], sl[1]))
+	res.A = a
+
+	return res, nil
+}
+
+func alphaFromArgs(args []string) (uint8, error) {
+	if len(args) < 4 {
+		return 255, nil
+	}
+
+	v, percent, err := parseColorNumber(args[3])
+	if err != nil {
+		return 0, err
+	}
+
+	if percent {
+		v /= 100
+	}
+
+	return clampToUint8(v * 255), nil
+}
+
+// parseColorNumber parses a number or a percentage, reporting whether it was a percentage
+func parseColorNumber(s string) (float64, bool, error) {
+	percent := strings.HasSuffix(s, "%")
+	if percent {
+		s = s[:len(s)-1]
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsInf(v, 0) || math.IsNaN(v) {
+		return 0, false, fmt.Errorf("invalid number: %s", s)
+	}
+
+	return v, percent, nil
+}
+
+// parseHue parses an angle and returns it in degrees, numbers without unit are degrees as well
+func parseHue(s string) (float64, error) {
+	units := []struct {
+		suffix  string
+		degrees float64
+	}{
+		{"deg", 1},
+		{"grad", 360.0 / 400},
+		{"rad", 180 / math.Pi},
+		{"turn", 360},
+	}
+
+	factor := 1.0
+	for _, u := range units {
+		if strings.HasSuffix(s, u.suffix) {
+			s, factor = s[:len(s)-len(u.suffix)], u.degrees
+			break
+		}
+	}
+
+	v, percent, err := parseColorNumber(s)
+	if err != nil {
+		return 0, err
+	}
+	if percent {
+		return 0, fmt.Errorf("invalid hue: %s%%", s)
+	}
+
+	return v * factor, nil
+}
+
+// hslToRGB converts a hue in degrees, a saturation and a lightness between 0 and 1 to
+// red, green and blue values between 0 and 1
+func hslToRGB(h, s, l float64) (float64, float64, float64) {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+
+	f := func(n float64) float64 {
+		k := math.Mod(n+h/30, 12)
+		a := s * math.Min(l, 1-l)
+
+		return l - a*math.Max(-1, math.Min(math.Min(k-3, 9-k), 1))
+	}
+
+	return f(0), f(8), f(4)
+}
+
+func clampToUint8(v float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(255, v))))
+}
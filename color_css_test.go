@@ -0,0 +1,56 @@
+package svg
+
+import (
+	"image/color"
+	"reflect"
+	"testing"
+)
+
+func TestColorFromCSSFunction(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Color
+		wantErr bool
+	}{
+		{"rgb legacy", "rgb(255, 128, 0)", Color{color.RGBA{255, 128, 0, 255}}, false},
+		{"rgb legacy percentages", "rgb(100%, 50%, 0%)", Color{color.RGBA{255, 128, 0, 255}}, false},
+		{"rgba legacy", "rgba(255, 128, 0, 0.5)", Color{color.RGBA{255, 128, 0, 128}}, false},
+		{"rgba legacy percentage alpha", "rgba(255,128,0,25%)", Color{color.RGBA{255, 128, 0, 64}}, false},
+		{"rgb modern", "rgb(255 128 0)", Color{color.RGBA{255, 128, 0, 255}}, false},
+		{"rgb modern with alpha", "rgb(255 128 0 / 0.5)", Color{color.RGBA{255, 128, 0, 128}}, false},
+		{"rgba modern with alpha", "RGBA( 255  128 0/50% )", Color{color.RGBA{255, 128, 0, 128}}, false},
+		{"rgb decimals", "rgb(10.4 10.6 0)", Color{color.RGBA{10, 11, 0, 255}}, false},
+		{"rgb clamped", "rgb(300, -10, 0, 2)", Color{color.RGBA{255, 0, 0, 255}}, false},
+		{"hsl legacy", "hsl(120, 100%, 50%)", Color{color.RGBA{0, 255, 0, 255}}, false},
+		{"hsla legacy", "hsla(240, 100%, 50%, 0.5)", Color{color.RGBA{0, 0, 255, 128}}, false},
+		{"hsl modern", "hsl(0deg 100% 50% / 1)", Color{color.RGBA{255, 0, 0, 255}}, false},
+		{"hsl turn", "hsl(0.5turn 100% 25%)", Color{color.RGBA{0, 128, 128, 255}}, false},
+		{"hsl rad", "hsl(3.14159265rad 100% 50%)", Color{color.RGBA{0, 255, 255, 255}}, false},
+		{"hsl grad", "hsl(400grad 100% 50%)", Color{color.RGBA{255, 0, 0, 255}}, false},
+		{"hsl negative hue", "hsl(-120 100% 50%)", Color{color.RGBA{0, 0, 255, 255}}, false},
+		{"hsl gray", "hsl(0, 0%, 50%)", Color{color.RGBA{128, 128, 128, 255}}, false},
+		{"unknown function", "cmyk(0, 0, 0, 0)", Color{}, true},
+		{"missing parenthesis", "rgb(0, 0, 0", Color{}, true},
+		{"too few arguments", "rgb(0 0)", Color{}, true},
+		{"too many arguments", "rgb(0, 0, 0, 0, 0)", Color{}, true},
+		{"mixed separators", "rgb(0, 0, 0 / 1)", Color{}, true},
+		{"missing comma", "rgb(0, 0 0)", Color{}, true},
+		{"empty alpha", "rgb(0 0 0 /)", Color{}, true},
+		{"invalid number", "rgb(a b c)", Color{}, true},
+		{"percentage hue", "hsl(10% 100% 50%)", Color{}, true},
+		{"infinite number", "rgb(inf 0 0)", Color{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ColorFromCSSFunction(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ColorFromCSSFunction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ColorFromCSSFunction() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,150 +2,207 @@ package svg
 
 import (
 	"fmt"
+	"math"
+	"sort"
 )
 
 type ColorName string
 
 const (
-	LightSalmon          ColorName = "lightsalmon"
-	Salmon                         = "salmon"
-	DarkSalmon                     = "darksalmon"
-	LightCoral                     = "lightcoral"
-	IndianRed                      = "indianred"
-	Crimson                        = "crimson"
-	Firebrick                      = "firebrick"
-	Red                            = "red"
-	DarkRed                        = "darkred"
-	Coral                          = "coral"
-	Tomato                         = "tomato"
-	OrangeRed                      = "orangered"
-	Gold                           = "gold"
-	Orange                         = "orange"
-	DarkOrange                     = "darkorange"
-	LightYellow                    = "lightyellow"
-	Lemonchiffon                   = "lemonchiffon"
-	LightGoldenrodYellow           = "lightgoldenrodyellow"
-	PapayaWhip                     = "papayawhip"
-	Moccasin                       = "moccasin"
-	Peachpuff                      = "peachpuff"
-	PaleGoldenrod                  = "palegoldenrod"
-	Khaki                          = "khaki"
-	DarkKhaki                      = "darkkhaki"
-	Yellow                         = "yellow"
-	LawnGreen                      = "lawngreen"
-	Chartreuse                     = "chartreuse"
-	LimeGreen                      = "limegreen"
-	Lime                           = "lime"
-	ForestGreen                    = "forestgreen"
-	Green                          = "green"
-	DarkGreen                      = "darkgreen"
-	GreenYellow                    = "greenyellow"
-	YellowGreen                    = "yellowgreen"
-	SpringGreen                    = "springgreen"
-	MediumSpringGreen              = "mediumspringgreen"
-	LightGreen                     = "lightgreen"
-	PaleGreen                      = "palegreen"
-	DarkSeaGreen                   = "darkseagreen"
-	MediumSeaGreen                 = "mediumseagreen"
-	SeaGreen                       = "seagreen"
-	Olive                          = "olive"
-	DarkOliveGreen                 = "darkolivegreen"
-	OliveDrab                      = "olivedrab"
-	LightCyan                      = "lightcyan"
-	Cyan                           = "cyan"
-	Aqua                           = "aqua"
-	Aquamarine                     = "aquamarine"
-	MediumAquamarine               = "mediumaquamarine"
-	PaleTurquoise                  = "paleturquoise"
-	Turquoise                      = "turquoise"
-	MediumTurquoise                = "mediumturquoise"
-	DarkTurquoise                  = "darkturquoise"
-	LightSeaGreen                  = "lightseagreen"
-	CadetBlue                      = "cadetblue"
-	DarkCyan                       = "darkcyan"
-	Teal                           = "teal"
-	PowderBlue                     = "powderblue"
-	LightBlue                      = "lightblue"
-	LightskyBlue                   = "lightskyblue"
-	SkyBlue                        = "skyblue"
-	DeepskyBlue                    = "deepskyblue"
-	LightsteelBlue                 = "lightsteelblue"
-	DodgerBlue                     = "dodgerblue"
-	CornflowerBlue                 = "cornflowerblue"
-	SteelBlue                      = "steelblue"
-	RoyalBlue                      = "royalblue"
-	Blue                           = "blue"
-	MediumBlue                     = "mediumblue"
-	DarkBlue                       = "darkblue"
-	Navy                           = "navy"
-	MidnightBlue                   = "midnightblue"
-	MediumslateBlue                = "mediumslateblue"
-	SlateBlue                      = "slateblue"
-	DarkslateBlue                  = "darkslateblue"
-	Lavender                       = "lavender"
-	Thistle                        = "thistle"
-	Plum                           = "plum"
-	Violet                         = "violet"
-	Orchid                         = "orchid"
-	Fuchsia                        = "fuchsia"
-	Magenta                        = "magenta"
-	MediumOrchid                   = "mediumorchid"
-	Mediumpurple                   = "mediumpurple"
-	BlueViolet                     = "blueviolet"
-	DarkViolet                     = "darkviolet"
-	DarkOrchid                     = "darkorchid"
-	Darkmagenta                    = "darkmagenta"
-	Purple                         = "purple"
-	Indigo                         = "indigo"
-	Pink                           = "pink"
-	LightPink                      = "lightpink"
-	HotPink                        = "hotpink"
-	DeepPink                       = "deeppink"
-	PaleVioletRed                  = "palevioletred"
-	MediumVioletRed                = "mediumvioletred"
-	White                          = "white"
-	Snow                           = "snow"
-	Honeydew                       = "honeydew"
-	MintCream                      = "mintcream"
-	Azure                          = "azure"
-	AliceBlue                      = "aliceblue"
-	GhostWhite                     = "ghostwhite"
-	WhiteSmoke                     = "whitesmoke"
-	Seashell                       = "seashell"
-	Beige                          = "beige"
-	OldLace                        = "oldlace"
-	FloralWhite                    = "floralwhite"
-	Ivory                          = "ivory"
-	AntiqueWhite                   = "antiquewhite"
-	Linen                          = "linen"
-	LavenderBlush                  = "lavenderblush"
-	MistyRose                      = "mistyrose"
-	Gainsboro                      = "gainsboro"
-	LightGray                      = "lightgray"
-	Silver                         = "silver"
-	DarkGray                       = "darkgray"
-	Gray                           = "gray"
-	DimGray                        = "dimgray"
-	LightslateGray                 = "lightslategray"
-	SlateGray                      = "slategray"
-	DarkslateGray                  = "darkslategray"
-	Black                          = "black"
-	Cornsilk                       = "cornsilk"
-	BlanchedAlmond                 = "blanchedalmond"
-	Bisque                         = "bisque"
-	NavajoWhite                    = "navajowhite"
-	Wheat                          = "wheat"
-	BurlyWood                      = "burlywood"
-	Tan                            = "tan"
-	RosyBrown                      = "rosybrown"
-	SandyBrown                     = "sandybrown"
-	Goldenrod                      = "goldenrod"
-	Peru                           = "peru"
-	Chocolate                      = "chocolate"
-	SaddleBrown                    = "saddlebrown"
-	Sienna                         = "sienna"
-	Brown                          = "brown"
-	Maroon                         = "maroon"
+	// Reds
+	LightSalmon ColorName = "lightsalmon"
+	Salmon      ColorName = "salmon"
+	DarkSalmon  ColorName = "darksalmon"
+	LightCoral  ColorName = "lightcoral"
+	IndianRed   ColorName = "indianred"
+	Crimson     ColorName = "crimson"
+	Firebrick   ColorName = "firebrick"
+	Red         ColorName = "red"
+	DarkRed     ColorName = "darkred"
+
+	// Oranges
+	Coral      ColorName = "coral"
+	Tomato     ColorName = "tomato"
+	OrangeRed  ColorName = "orangered"
+	Gold       ColorName = "gold"
+	Orange     ColorName = "orange"
+	DarkOrange ColorName = "darkorange"
+
+	// Yellows
+	LightYellow          ColorName = "lightyellow"
+	LemonChiffon         ColorName = "lemonchiffon"
+	LightGoldenrodYellow ColorName = "lightgoldenrodyellow"
+	PapayaWhip           ColorName = "papayawhip"
+	Moccasin             ColorName = "moccasin"
+	PeachPuff            ColorName = "peachpuff"
+	PaleGoldenrod        ColorName = "palegoldenrod"
+	Khaki                ColorName = "khaki"
+	DarkKhaki            ColorName = "darkkhaki"
+	Yellow               ColorName = "yellow"
+
+	// Greens
+	LawnGreen         ColorName = "lawngreen"
+	Chartreuse        ColorName = "chartreuse"
+	LimeGreen         ColorName = "limegreen"
+	Lime              ColorName = "lime"
+	ForestGreen       ColorName = "forestgreen"
+	Green             ColorName = "green"
+	DarkGreen         ColorName = "darkgreen"
+	GreenYellow       ColorName = "greenyellow"
+	YellowGreen       ColorName = "yellowgreen"
+	SpringGreen       ColorName = "springgreen"
+	MediumSpringGreen ColorName = "mediumspringgreen"
+	LightGreen        ColorName = "lightgreen"
+	PaleGreen         ColorName = "palegreen"
+	DarkSeaGreen      ColorName = "darkseagreen"
+	MediumSeaGreen    ColorName = "mediumseagreen"
+	SeaGreen          ColorName = "seagreen"
+	Olive             ColorName = "olive"
+	DarkOliveGreen    ColorName = "darkolivegreen"
+	OliveDrab         ColorName = "olivedrab"
+
+	// Cyans
+	LightCyan        ColorName = "lightcyan"
+	Cyan             ColorName = "cyan"
+	Aqua             ColorName = "aqua"
+	Aquamarine       ColorName = "aquamarine"
+	MediumAquamarine ColorName = "mediumaquamarine"
+	PaleTurquoise    ColorName = "paleturquoise"
+	Turquoise        ColorName = "turquoise"
+	MediumTurquoise  ColorName = "mediumturquoise"
+	DarkTurquoise    ColorName = "darkturquoise"
+	LightSeaGreen    ColorName = "lightseagreen"
+	CadetBlue        ColorName = "cadetblue"
+	DarkCyan         ColorName = "darkcyan"
+	Teal             ColorName = "teal"
+
+	// Blues
+	PowderBlue      ColorName = "powderblue"
+	LightBlue       ColorName = "lightblue"
+	LightSkyBlue    ColorName = "lightskyblue"
+	SkyBlue         ColorName = "skyblue"
+	DeepSkyBlue     ColorName = "deepskyblue"
+	LightSteelBlue  ColorName = "lightsteelblue"
+	DodgerBlue      ColorName = "dodgerblue"
+	CornflowerBlue  ColorName = "cornflowerblue"
+	SteelBlue       ColorName = "steelblue"
+	RoyalBlue       ColorName = "royalblue"
+	Blue            ColorName = "blue"
+	MediumBlue      ColorName = "mediumblue"
+	DarkBlue        ColorName = "darkblue"
+	Navy            ColorName = "navy"
+	MidnightBlue    ColorName = "midnightblue"
+	MediumSlateBlue ColorName = "mediumslateblue"
+	SlateBlue       ColorName = "slateblue"
+	DarkSlateBlue   ColorName = "darkslateblue"
+
+	// Purples
+	Lavender     ColorName = "lavender"
+	Thistle      ColorName = "thistle"
+	Plum         ColorName = "plum"
+	Violet       ColorName = "violet"
+	Orchid       ColorName = "orchid"
+	Fuchsia      ColorName = "fuchsia"
+	Magenta      ColorName = "magenta"
+	MediumOrchid ColorName = "mediumorchid"
+	MediumPurple ColorName = "mediumpurple"
+	BlueViolet   ColorName = "blueviolet"
+	DarkViolet   ColorName = "darkviolet"
+	DarkOrchid   ColorName = "darkorchid"
+	DarkMagenta  ColorName = "darkmagenta"
+	Purple       ColorName = "purple"
+	Indigo       ColorName = "indigo"
+
+	// Pinks
+	Pink            ColorName = "pink"
+	LightPink       ColorName = "lightpink"
+	HotPink         ColorName = "hotpink"
+	DeepPink        ColorName = "deeppink"
+	PaleVioletRed   ColorName = "palevioletred"
+	MediumVioletRed ColorName = "mediumvioletred"
+
+	// Whites
+	White         ColorName = "white"
+	Snow          ColorName = "snow"
+	Honeydew      ColorName = "honeydew"
+	MintCream     ColorName = "mintcream"
+	Azure         ColorName = "azure"
+	AliceBlue     ColorName = "aliceblue"
+	GhostWhite    ColorName = "ghostwhite"
+	WhiteSmoke    ColorName = "whitesmoke"
+	Seashell      ColorName = "seashell"
+	Beige         ColorName = "beige"
+	OldLace       ColorName = "oldlace"
+	FloralWhite   ColorName = "floralwhite"
+	Ivory         ColorName = "ivory"
+	AntiqueWhite  ColorName = "antiquewhite"
+	Linen         ColorName = "linen"
+	LavenderBlush ColorName = "lavenderblush"
+	MistyRose     ColorName = "mistyrose"
+
+	// Grays
+	Gainsboro      ColorName = "gainsboro"
+	LightGray      ColorName = "lightgray"
+	LightGrey      ColorName = "lightgrey"
+	Silver         ColorName = "silver"
+	DarkGray       ColorName = "darkgray"
+	DarkGrey       ColorName = "darkgrey"
+	Gray           ColorName = "gray"
+	Grey           ColorName = "grey"
+	DimGray        ColorName = "dimgray"
+	DimGrey        ColorName = "dimgrey"
+	LightSlateGray ColorName = "lightslategray"
+	LightSlateGrey ColorName = "lightslategrey"
+	SlateGray      ColorName = "slategray"
+	SlateGrey      ColorName = "slategrey"
+	DarkSlateGray  ColorName = "darkslategray"
+	DarkSlateGrey  ColorName = "darkslategrey"
+	Black          ColorName = "black"
+
+	// Browns
+	Cornsilk       ColorName = "cornsilk"
+	BlanchedAlmond ColorName = "blanchedalmond"
+	Bisque         ColorName = "bisque"
+	NavajoWhite    ColorName = "navajowhite"
+	Wheat          ColorName = "wheat"
+	BurlyWood      ColorName = "burlywood"
+	Tan            ColorName = "tan"
+	RosyBrown      ColorName = "rosybrown"
+	SandyBrown     ColorName = "sandybrown"
+	Goldenrod      ColorName = "goldenrod"
+	DarkGoldenrod  ColorName = "darkgoldenrod"
+	Peru           ColorName = "peru"
+	Chocolate      ColorName = "chocolate"
+	SaddleBrown    ColorName = "saddlebrown"
+	Sienna         ColorName = "sienna"
+	Brown          ColorName = "brown"
+	Maroon         ColorName = "maroon"
+)
+
+// Deprecated color name constants, kept for backwards compatibility
+const (
+	// Deprecated: use LemonChiffon
+	Lemonchiffon = LemonChiffon
+	// Deprecated: use PeachPuff
+	Peachpuff = PeachPuff
+	// Deprecated: use LightSkyBlue
+	LightskyBlue = LightSkyBlue
+	// Deprecated: use DeepSkyBlue
+	DeepskyBlue = DeepSkyBlue
+	// Deprecated: use LightSteelBlue
+	LightsteelBlue = LightSteelBlue
+	// Deprecated: use MediumSlateBlue
+	MediumslateBlue = MediumSlateBlue
+	// Deprecated: use DarkSlateBlue
+	DarkslateBlue = DarkSlateBlue
+	// Deprecated: use MediumPurple
+	Mediumpurple = MediumPurple
+	// Deprecated: use DarkMagenta
+	Darkmagenta = DarkMagenta
+	// Deprecated: use LightSlateGray
+	LightslateGray = LightSlateGray
+	// Deprecated: use DarkSlateGray
+	DarkslateGray = DarkSlateGray
 )
 
 var nameToHexa = map[ColorName]string{
@@ -168,11 +225,11 @@ var nameToHexa = map[ColorName]string{
 	DarkOrange: "#ff8c00",
 	// Yellows
 	LightYellow:          "#ffffe0",
-	Lemonchiffon:         "#fffacd",
+	LemonChiffon:         "#fffacd",
 	LightGoldenrodYellow: "#fafad2",
 	PapayaWhip:           "#ffefd5",
 	Moccasin:             "#ffe4b5",
-	Peachpuff:            "#ffdab9",
+	PeachPuff:            "#ffdab9",
 	PaleGoldenrod:        "#eee8aa",
 	Khaki:                "#f0e68c",
 	DarkKhaki:            "#bdb76b",
@@ -214,10 +271,10 @@ var nameToHexa = map[ColorName]string{
 	// Blues
 	PowderBlue:      "#b0e0e6",
 	LightBlue:       "#add8e6",
-	LightskyBlue:    "#87cefa",
+	LightSkyBlue:    "#87cefa",
 	SkyBlue:         "#87ceeb",
-	DeepskyBlue:     "#00bfff",
-	LightsteelBlue:  "#b0c4de",
+	DeepSkyBlue:     "#00bfff",
+	LightSteelBlue:  "#b0c4de",
 	DodgerBlue:      "#1e90ff",
 	CornflowerBlue:  "#6495ed",
 	SteelBlue:       "#4682b4",
@@ -227,9 +284,9 @@ var nameToHexa = map[ColorName]string{
 	DarkBlue:        "#00008b",
 	Navy:            "#000080",
 	MidnightBlue:    "#191970",
-	MediumslateBlue: "#7b68ee",
+	MediumSlateBlue: "#7b68ee",
 	SlateBlue:       "#6a5acd",
-	DarkslateBlue:   "#483d8b",
+	DarkSlateBlue:   "#483d8b",
 	// Purples
 	Lavender:     "#e6e6fa",
 	Thistle:      "#d8bfd8",
@@ -239,11 +296,11 @@ var nameToHexa = map[ColorName]string{
 	Fuchsia:      "#ff00ff",
 	Magenta:      "#ff00ff",
 	MediumOrchid: "#ba55d3",
-	Mediumpurple: "#9370db",
+	MediumPurple: "#9370db",
 	BlueViolet:   "#8a2be2",
 	DarkViolet:   "#9400d3",
 	DarkOrchid:   "#9932cc",
-	Darkmagenta:  "#8b008b",
+	DarkMagenta:  "#8b008b",
 	Purple:       "#800080",
 	Indigo:       "#4b0082",
 	// Pinks
@@ -274,13 +331,20 @@ var nameToHexa = map[ColorName]string{
 	// Grays
 	Gainsboro:      "#dcdcdc",
 	LightGray:      "#d3d3d3",
+	LightGrey:      "#d3d3d3",
 	Silver:         "#c0c0c0",
 	DarkGray:       "#a9a9a9",
+	DarkGrey:       "#a9a9a9",
 	Gray:           "#808080",
+	Grey:           "#808080",
 	DimGray:        "#696969",
-	LightslateGray: "#778899",
+	DimGrey:        "#696969",
+	LightSlateGray: "#778899",
+	LightSlateGrey: "#778899",
 	SlateGray:      "#708090",
-	DarkslateGray:  "#2f4f4f",
+	SlateGrey:      "#708090",
+	DarkSlateGray:  "#2f4f4f",
+	DarkSlateGrey:  "#2f4f4f",
 	Black:          "#000000",
 	// Browns
 	Cornsilk:       "#fff8dc",
@@ -293,6 +357,7 @@ var nameToHexa = map[ColorName]string{
 	RosyBrown:      "#bc8f8f",
 	SandyBrown:     "#f4a460",
 	Goldenrod:      "#daa520",
+	DarkGoldenrod:  "#b8860b",
 	Peru:           "#cd853f",
 	Chocolate:      "#d2691e",
 	SaddleBrown:    "#8b4513",
@@ -329,3 +394,75 @@ func (cn ColorName) ToHexa() string {
 
 	return h
 }
+
+// hexaToName maps the hexadecimal value of the named colors to their names
+// For colors with multiple names, e.g. aqua and cyan, the shortest name wins, then the alphabetically first one.
+var hexaToName = func() map[string]ColorName {
+	res := make(map[string]ColorName, len(nameToHexa))
+
+	for cn, h := range nameToHexa {
+		prev, ok := res[h]
+		if !ok || len(cn) < len(prev) || (len(cn) == len(prev) && cn < prev) {
+			res[h] = cn
+		}
+	}
+
+	return res
+}()
+
+// namedColors holds the distinct named colors in CIE Lab, sorted by name
+var namedColors = func() []namedColor {
+	res := make([]namedColor, 0, len(hexaToName))
+
+	for _, cn := range hexaToName {
+		l, a, b := cn.ToColor().ToLab()
+		res = append(res, namedColor{name: cn, l: l, a: a, b: b})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].name < res[j].name
+	})
+
+	return res
+}()
+
+type namedColor struct {
+	name    ColorName
+	l, a, b float64
+}
+
+// Name returns the name of a Color if it exactly matches one of the named colors
+// Colors which are not fully opaque have no name.
+func (c Color) Name() (ColorName, bool) {
+	if c.A != 255 {
+		return "", false
+	}
+
+	cn, ok := hexaToName[c.Format(ColorHex)]
+
+	return cn, ok
+}
+
+// NearestName returns the name of the named color perceptually closest to a Color, ignoring alpha
+// The distance used is the CIE76 color difference, the euclidean distance in the CIE Lab color space.
+func (c Color) NearestName() ColorName {
+	if cn, ok := c.Opaque().Name(); ok {
+		return cn
+	}
+
+	l, a, b := c.ToLab()
+
+	var (
+		nearest ColorName
+		minDist = math.Inf(1)
+	)
+
+	for _, nc := range namedColors {
+		dist := (l-nc.l)*(l-nc.l) + (a-nc.a)*(a-nc.a) + (b-nc.b)*(b-nc.b)
+		if dist < minDist {
+			nearest, minDist = nc.name, dist
+		}
+	}
+
+	return nearest
+}
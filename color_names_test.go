@@ -110,3 +110,84 @@ func TestColorName_ToHexa(t *testing.T) {
 		cn.ToHexa()
 	})
 }
+
+func TestColorName_Constants(t *testing.T) {
+	if len(nameToHexa) != 147 {
+		t.Errorf("len(nameToHexa) = %d, want 147", len(nameToHexa))
+	}
+
+	for _, cn := range []interface{}{Salmon, Grey, DarkGoldenrod, LightSlateGrey, Maroon, Mediumpurple} {
+		if _, ok := cn.(ColorName); !ok {
+			t.Errorf("%v is %T, want ColorName", cn, cn)
+		}
+	}
+
+	for cn := range nameToHexa {
+		if _, err := NewColorName(string(cn)); err != nil {
+			t.Errorf("NewColorName(%q) error = %v", cn, err)
+		}
+	}
+}
+
+func TestColor_Name(t *testing.T) {
+	tests := []struct {
+		name   string
+		c      Color
+		want   ColorName
+		wantOk bool
+	}{
+		{"red", Color{color.RGBA{255, 0, 0, 255}}, Red, true},
+		{"aqua and cyan", Color{color.RGBA{0, 255, 255, 255}}, Aqua, true},
+		{"gray and grey", Color{color.RGBA{128, 128, 128, 255}}, Gray, true},
+		{"no exact match", Color{color.RGBA{254, 0, 0, 255}}, "", false},
+		{"transparent", Color{color.RGBA{255, 0, 0, 128}}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.c.Name()
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Name() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestColor_NearestName(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Color
+		want ColorName
+	}{
+		{"exact", Color{color.RGBA{255, 0, 0, 255}}, Red},
+		{"exact ignoring alpha", Color{color.RGBA{0, 0, 128, 0}}, Navy},
+		{"almost red", Color{color.RGBA{250, 5, 5, 255}}, Red},
+		{"almost white", Color{color.RGBA{254, 254, 254, 255}}, White},
+		{"dark orange-ish", Color{color.RGBA{250, 140, 5, 255}}, DarkOrange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.NearestName(); got != tt.want {
+				t.Errorf("NearestName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColor_Format_ColorKeyword(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Color
+		want string
+	}{
+		{"named", Color{color.RGBA{255, 0, 0, 255}}, "red"},
+		{"unnamed", Color{color.RGBA{254, 0, 0, 255}}, "#fe0000"},
+		{"transparent", Color{color.RGBA{255, 0, 0, 128}}, "#ff0000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Format(ColorKeyword); got != tt.want {
+				t.Errorf("Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
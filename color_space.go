@@ -0,0 +1,350 @@
+package svg
+
+import (
+	"image/color"
+	"math"
+)
+
+// D65 reference white used for the XYZ and Lab conversions
+const (
+	d65X = 0.95047
+	d65Y = 1.0
+	d65Z = 1.08883
+)
+
+// CIE Lab constants, see: http://www.brucelindbloom.com/index.html?LContinuity.html
+const (
+	labEpsilon = 216.0 / 24389
+	labKappa   = 24389.0 / 27
+)
+
+// srgbToLinear removes the sRGB gamma from a channel between 0 and 1
+func srgbToLinear(v float64) float64 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+// linearToSRGB applies the sRGB gamma to a linear channel between 0 and 1
+func linearToSRGB(v float64) float64 {
+	if v <= 0.0031308 {
+		return v * 12.92
+	}
+
+	return 1.055*math.Pow(v, 1/2.4) - 0.055
+}
+
+// colorFromFloats constructs an opaque Color from red, green and blue values between 0 and 1
+// Out of gamut values are clamped.
+func colorFromFloats(r, g, b float64) Color {
+	return Color{RGBA: color.RGBA{R: clampToUint8(r * 255), G: clampToUint8(g * 255), B: clampToUint8(b * 255), A: 255}}
+}
+
+// ToHSL converts a Color to hue in degrees, saturation and lightness between 0 and 1
+func (c Color) ToHSL() (float64, float64, float64) {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+
+	hi := math.Max(r, math.Max(g, b))
+	lo := math.Min(r, math.Min(g, b))
+
+	l := (hi + lo) / 2
+	if hi == lo {
+		return 0, 0, l
+	}
+
+	d := hi - lo
+
+	s := d / (1 - math.Abs(2*l-1))
+
+	return hue(r, g, b, hi, d), s, l
+}
+
+// ColorFromHSL constructs an opaque Color from hue in degrees, saturation and lightness between 0 and 1
+func ColorFromHSL(h, s, l float64) Color {
+	return colorFromFloats(hslToRGB(h, clamp01(s), clamp01(l)))
+}
+
+// ToHSV converts a Color to hue in degrees, saturation and value between 0 and 1
+func (c Color) ToHSV() (float64, float64, float64) {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+
+	hi := math.Max(r, math.Max(g, b))
+	lo := math.Min(r, math.Min(g, b))
+
+	if hi == lo {
+		return 0, 0, hi
+	}
+
+	d := hi - lo
+
+	return hue(r, g, b, hi, d), d / hi, hi
+}
+
+// ColorFromHSV constructs an opaque Color from hue in degrees, saturation and value between 0 and 1
+func ColorFromHSV(h, s, v float64) Color {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	s, v = clamp01(s), clamp01(v)
+
+	f := func(n float64) float64 {
+		k := math.Mod(n+h/60, 6)
+
+		return v - v*s*math.Max(0, math.Min(math.Min(k, 4-k), 1))
+	}
+
+	return colorFromFloats(f(5), f(3), f(1))
+}
+
+// hue returns the hue in degrees of red, green and blue values, hi is the largest of them and d is the
+// difference of the largest and the smallest one, which must not be zero
+func hue(r, g, b, hi, d float64) float64 {
+	var h float64
+
+	switch hi {
+	case r:
+		h = math.Mod((g-b)/d, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+
+	return h
+}
+
+// ToLinearRGB converts a Color to linear red, green and blue values between 0 and 1
+func (c Color) ToLinearRGB() (float64, float64, float64) {
+	return srgbToLinear(float64(c.R) / 255), srgbToLinear(float64(c.G) / 255), srgbToLinear(float64(c.B) / 255)
+}
+
+// ColorFromLinearRGB constructs an opaque Color from linear red, green and blue values between 0 and 1
+func ColorFromLinearRGB(r, g, b float64) Color {
+	return colorFromFloats(linearToSRGB(r), linearToSRGB(g), linearToSRGB(b))
+}
+
+// xyz converts a Color to the CIE XYZ color space, using the D65 white point
+func (c Color) xyz() (float64, float64, float64) {
+	r, g, b := c.ToLinearRGB()
+
+	x := 0.4124564*r + 0.3575761*g + 0.1804375*b
+	y := 0.2126729*r + 0.7151522*g + 0.0721750*b
+	z := 0.0193339*r + 0.1191920*g + 0.9503041*b
+
+	return x, y, z
+}
+
+// colorFromXYZ constructs an opaque Color from the CIE XYZ color space, using the D65 white point
+func colorFromXYZ(x, y, z float64) Color {
+	r := 3.2404542*x - 1.5371385*y - 0.4985314*z
+	g := -0.9692660*x + 1.8760108*y + 0.0415560*z
+	b := 0.0556434*x - 0.2040259*y + 1.0572252*z
+
+	return ColorFromLinearRGB(r, g, b)
+}
+
+// ToLab converts a Color to the CIE Lab color space, using the D65 white point
+// L is between 0 and 100, a and b are roughly between -128 and 127.
+func (c Color) ToLab() (float64, float64, float64) {
+	x, y, z := c.xyz()
+
+	f := func(t float64) float64 {
+		if t > labEpsilon {
+			return math.Cbrt(t)
+		}
+
+		return (labKappa*t + 16) / 116
+	}
+
+	fx, fy, fz := f(x/d65X), f(y/d65Y), f(z/d65Z)
+
+	return 116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)
+}
+
+// ColorFromLab constructs an opaque Color from the CIE Lab color space, using the D65 white point
+// Colors outside of the sRGB gamut are clamped.
+func ColorFromLab(l, a, b float64) Color {
+	fy := (l + 16) / 116
+	fx := fy + a/500
+	fz := fy - b/200
+
+	finv := func(t float64) float64 {
+		if t*t*t > labEpsilon {
+			return t * t * t
+		}
+
+		return (116*t - 16) / labKappa
+	}
+
+	y := l / labKappa
+	if l > labKappa*labEpsilon {
+		y = fy * fy * fy
+	}
+
+	return colorFromXYZ(finv(fx)*d65X, y*d65Y, finv(fz)*d65Z)
+}
+
+// ToLCh converts a Color to the cylindrical form of the CIE Lab color space
+// L is between 0 and 100, the chroma starts at 0 and the hue is in degrees.
+func (c Color) ToLCh() (float64, float64, float64) {
+	return toPolar(c.ToLab())
+}
+
+// ColorFromLCh constructs an opaque Color from the cylindrical form of the CIE Lab color space
+func ColorFromLCh(l, ch, h float64) Color {
+	return ColorFromLab(fromPolar(l, ch, h))
+}
+
+// ToOKLab converts a Color to the OKLab color space
+// L is between 0 and 1, a and b are roughly between -0.4 and 0.4.
+// See: https://bottosson.github.io/posts/oklab/
+func (c Color) ToOKLab() (float64, float64, float64) {
+	r, g, b := c.ToLinearRGB()
+
+	l := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	m := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	s := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+
+	return 0.2104542553*l + 0.7936177850*m - 0.0040720468*s,
+		1.9779984951*l - 2.4285922050*m + 0.4505937099*s,
+		0.0259040371*l + 0.7827717662*m - 0.8086757660*s
+}
+
+// ColorFromOKLab constructs an opaque Color from the OKLab color space
+// Colors outside of the sRGB gamut are clamped.
+func ColorFromOKLab(l, a, b float64) Color {
+	lp := l + 0.3963377774*a + 0.2158037573*b
+	mp := l - 0.1055613458*a - 0.0638541728*b
+	sp := l - 0.0894841775*a - 1.2914855480*b
+
+	l3, m3, s3 := lp*lp*lp, mp*mp*mp, sp*sp*sp
+
+	return ColorFromLinearRGB(
+		4.0767416621*l3-3.3077115913*m3+0.2309699292*s3,
+		-1.2684380046*l3+2.6097574011*m3-0.3413193965*s3,
+		-0.0041960863*l3-0.7034186147*m3+1.7076147010*s3,
+	)
+}
+
+// ToOKLCh converts a Color to the cylindrical form of the OKLab color space
+// L is between 0 and 1, the chroma starts at 0 and the hue is in degrees.
+func (c Color) ToOKLCh() (float64, float64, float64) {
+	return toPolar(c.ToOKLab())
+}
+
+// ColorFromOKLCh constructs an opaque Color from the cylindrical form of the OKLab color space
+func ColorFromOKLCh(l, ch, h float64) Color {
+	return ColorFromOKLab(fromPolar(l, ch, h))
+}
+
+// toPolar converts the a and b axes of a Lab like color space to chroma and hue in degrees
+func toPolar(l, a, b float64) (float64, float64, float64) {
+	h := math.Atan2(b, a) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+
+	return l, math.Hypot(a, b), h
+}
+
+// fromPolar converts chroma and hue in degrees to the a and b axes of a Lab like color space
+func fromPolar(l, ch, h float64) (float64, float64, float64) {
+	sin, cos := math.Sincos(h * math.Pi / 180)
+
+	return l, ch * cos, ch * sin
+}
+
+// Lighten returns a Color with the HSL lightness increased by amount, which is between 0 and 1
+// A negative amount darkens the Color. The alpha channel is kept.
+func (c Color) Lighten(amount float64) Color {
+	h, s, l := c.ToHSL()
+
+	res := ColorFromHSL(h, s, l+amount)
+	res.A = c.A
+
+	return res
+}
+
+// Darken returns a Color with the HSL lightness decreased by amount, which is between 0 and 1
+func (c Color) Darken(amount float64) Color {
+	return c.Lighten(-amount)
+}
+
+// Saturate returns a Color with the HSL saturation increased by amount, which is between 0 and 1
+// A negative amount desaturates the Color. The alpha channel is kept.
+func (c Color) Saturate(amount float64) Color {
+	h, s, l := c.ToHSL()
+
+	res := ColorFromHSL(h, s+amount, l)
+	res.A = c.A
+
+	return res
+}
+
+// Desaturate returns a Color with the HSL saturation decreased by amount, which is between 0 and 1
+func (c Color) Desaturate(amount float64) Color {
+	return c.Saturate(-amount)
+}
+
+// Mix returns the Color at t between c and other, t is between 0 (c) and 1 (other)
+// Colors are mixed in the OKLab color space, which produces perceptually even transitions.
+// The alpha channel is interpolated linearly.
+func (c Color) Mix(other Color, t float64) Color {
+	t = clamp01(t)
+
+	l1, a1, b1 := c.ToOKLab()
+	l2, a2, b2 := other.ToOKLab()
+
+	res := ColorFromOKLab(l1+(l2-l1)*t, a1+(a2-a1)*t, b1+(b2-b1)*t)
+	res.A = clampToUint8(float64(c.A) + (float64(other.A)-float64(c.A))*t)
+
+	return res
+}
+
+// RelativeLuminance returns the relative luminance of a Color as defined by WCAG 2, ignoring alpha
+// See: https://www.w3.org/TR/WCAG21/#dfn-relative-luminance
+func (c Color) RelativeLuminance() float64 {
+	r, g, b := c.ToLinearRGB()
+
+	return 0.2126*r + 0.7152*g + 0.0722*b
+}
+
+// ContrastRatio returns the WCAG 2 contrast ratio of two colors, between 1 and 21
+// See: https://www.w3.org/TR/WCAG21/#dfn-contrast-ratio
+func (c Color) ContrastRatio(other Color) float64 {
+	l1, l2 := c.RelativeLuminance(), other.RelativeLuminance()
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+// ReadableTextColor returns the candidate with the highest contrast ratio against background
+// If no candidates are given, black or white is returned.
+func ReadableTextColor(background Color, candidates ...Color) Color {
+	if len(candidates) == 0 {
+		candidates = []Color{Black.ToColor(), White.ToColor()}
+	}
+
+	best, bestRatio := candidates[0], -1.0
+	for _, candidate := range candidates {
+		if ratio := background.ContrastRatio(candidate); ratio > bestRatio {
+			best, bestRatio = candidate, ratio
+		}
+	}
+
+	return best
+}
+
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
@@ -0,0 +1,192 @@
+package svg
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestColor_ToLab(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       Color
+		l, a, b float64
+	}{
+		{"white", Color{color.RGBA{255, 255, 255, 255}}, 100, 0, 0},
+		{"black", Color{color.RGBA{0, 0, 0, 255}}, 0, 0, 0},
+		{"red", Color{color.RGBA{255, 0, 0, 255}}, 53.2408, 80.0925, 67.2032},
+		{"blue", Color{color.RGBA{0, 0, 255, 255}}, 32.2970, 79.1875, -107.8602},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, a, b := tt.c.ToLab()
+			if math.Abs(l-tt.l) > 0.01 || math.Abs(a-tt.a) > 0.01 || math.Abs(b-tt.b) > 0.01 {
+				t.Errorf("ToLab() = %v, %v, %v, want %v, %v, %v", l, a, b, tt.l, tt.a, tt.b)
+			}
+		})
+	}
+}
+
+func TestColor_ToHSL(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       Color
+		h, s, l float64
+	}{
+		{"red", Color{color.RGBA{255, 0, 0, 255}}, 0, 1, 0.5},
+		{"gray", Color{color.RGBA{128, 128, 128, 255}}, 0, 0, 0.502},
+		{"teal", Color{color.RGBA{0, 128, 128, 255}}, 180, 1, 0.251},
+		{"magenta-ish", Color{color.RGBA{255, 0, 128, 255}}, 329.88, 1, 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, s, l := tt.c.ToHSL()
+			if math.Abs(h-tt.h) > 0.01 || math.Abs(s-tt.s) > 0.001 || math.Abs(l-tt.l) > 0.001 {
+				t.Errorf("ToHSL() = %v, %v, %v, want %v, %v, %v", h, s, l, tt.h, tt.s, tt.l)
+			}
+		})
+	}
+}
+
+func TestColor_ToHSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       Color
+		h, s, v float64
+	}{
+		{"red", Color{color.RGBA{255, 0, 0, 255}}, 0, 1, 1},
+		{"black", Color{color.RGBA{0, 0, 0, 255}}, 0, 0, 0},
+		{"olive", Color{color.RGBA{128, 128, 0, 255}}, 60, 1, 0.502},
+		{"light blue", Color{color.RGBA{128, 128, 255, 255}}, 240, 0.498, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, s, v := tt.c.ToHSV()
+			if math.Abs(h-tt.h) > 0.01 || math.Abs(s-tt.s) > 0.001 || math.Abs(v-tt.v) > 0.001 {
+				t.Errorf("ToHSV() = %v, %v, %v, want %v, %v, %v", h, s, v, tt.h, tt.s, tt.v)
+			}
+		})
+	}
+}
+
+func TestColor_ToOKLab(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       Color
+		l, a, b float64
+	}{
+		{"white", Color{color.RGBA{255, 255, 255, 255}}, 1, 0, 0},
+		{"red", Color{color.RGBA{255, 0, 0, 255}}, 0.6279, 0.2249, 0.1258},
+		{"green", Color{color.RGBA{0, 255, 0, 255}}, 0.8664, -0.2339, 0.1795},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, a, b := tt.c.ToOKLab()
+			if math.Abs(l-tt.l) > 0.001 || math.Abs(a-tt.a) > 0.001 || math.Abs(b-tt.b) > 0.001 {
+				t.Errorf("ToOKLab() = %v, %v, %v, want %v, %v, %v", l, a, b, tt.l, tt.a, tt.b)
+			}
+		})
+	}
+}
+
+func TestColor_RoundTrips(t *testing.T) {
+	colors := []Color{
+		{color.RGBA{0, 0, 0, 255}},
+		{color.RGBA{255, 255, 255, 255}},
+		{color.RGBA{255, 0, 0, 255}},
+		{color.RGBA{12, 200, 99, 255}},
+		{color.RGBA{70, 130, 180, 255}},
+		{color.RGBA{250, 235, 215, 255}},
+	}
+
+	conversions := map[string]func(Color) Color{
+		"HSL":       func(c Color) Color { return ColorFromHSL(c.ToHSL()) },
+		"HSV":       func(c Color) Color { return ColorFromHSV(c.ToHSV()) },
+		"LinearRGB": func(c Color) Color { return ColorFromLinearRGB(c.ToLinearRGB()) },
+		"Lab":       func(c Color) Color { return ColorFromLab(c.ToLab()) },
+		"LCh":       func(c Color) Color { return ColorFromLCh(c.ToLCh()) },
+		"OKLab":     func(c Color) Color { return ColorFromOKLab(c.ToOKLab()) },
+		"OKLCh":     func(c Color) Color { return ColorFromOKLCh(c.ToOKLCh()) },
+	}
+
+	for name, conversion := range conversions {
+		for _, c := range colors {
+			if got := conversion(c); got != c {
+				t.Errorf("%s round trip of %v = %v", name, c, got)
+			}
+		}
+	}
+}
+
+func TestColor_Manipulation(t *testing.T) {
+	red := Color{color.RGBA{255, 0, 0, 128}}
+
+	tests := []struct {
+		name string
+		got  Color
+		want Color
+	}{
+		{"lighten", red.Lighten(0.25), Color{color.RGBA{255, 128, 128, 128}}},
+		{"lighten beyond white", red.Lighten(2), Color{color.RGBA{255, 255, 255, 128}}},
+		{"darken", red.Darken(0.25), Color{color.RGBA{128, 0, 0, 128}}},
+		{"desaturate", red.Desaturate(1), Color{color.RGBA{128, 128, 128, 128}}},
+		{"saturate", Color{color.RGBA{191, 64, 64, 255}}.Saturate(0.5), Color{color.RGBA{255, 0, 0, 255}}},
+		{"mix start", red.Mix(Color{color.RGBA{0, 0, 255, 255}}, 0), red},
+		{"mix end", red.Mix(Color{color.RGBA{0, 0, 255, 255}}, 1), Color{color.RGBA{0, 0, 255, 255}}},
+		{"mix black and white", Color{color.RGBA{0, 0, 0, 255}}.Mix(Color{color.RGBA{255, 255, 255, 255}}, 0.5), Color{color.RGBA{99, 99, 99, 255}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColor_ContrastRatio(t *testing.T) {
+	black := Color{color.RGBA{0, 0, 0, 255}}
+	white := Color{color.RGBA{255, 255, 255, 255}}
+
+	tests := []struct {
+		name string
+		c, o Color
+		want float64
+	}{
+		{"black on white", black, white, 21},
+		{"white on black", white, black, 21},
+		{"same color", white, white, 1},
+		{"gray on white", Color{color.RGBA{118, 118, 118, 255}}, white, 4.54},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.ContrastRatio(tt.o); math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("ContrastRatio() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadableTextColor(t *testing.T) {
+	black := Color{color.RGBA{0, 0, 0, 255}}
+	white := Color{color.RGBA{255, 255, 255, 255}}
+	yellow := Color{color.RGBA{255, 255, 0, 255}}
+
+	tests := []struct {
+		name       string
+		background Color
+		candidates []Color
+		want       Color
+	}{
+		{"dark background", Color{color.RGBA{0, 0, 128, 255}}, nil, white},
+		{"light background", Color{color.RGBA{255, 255, 224, 255}}, nil, black},
+		{"custom candidates", Color{color.RGBA{0, 0, 128, 255}}, []Color{black, yellow}, yellow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReadableTextColor(tt.background, tt.candidates...); got != tt.want {
+				t.Errorf("ReadableTextColor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -80,6 +80,52 @@ func TestColor_String(t *testing.T) {
 	}
 }
 
+func TestColor_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		c      Color
+		format ColorFormat
+		want   string
+	}{
+		{"opaque hex", Color{color.RGBA{255, 0, 0, 255}}, ColorHex, "#ff0000"},
+		{"opaque rgba", Color{color.RGBA{255, 0, 0, 255}}, ColorRGBA, "#ff0000"},
+		{"transparent hex", Color{color.RGBA{255, 0, 0, 128}}, ColorHex, "#ff0000"},
+		{"transparent hex alpha", Color{color.RGBA{255, 0, 0, 128}}, ColorHexAlpha, "#ff000080"},
+		{"transparent rgba", Color{color.RGBA{255, 0, 0, 128}}, ColorRGBA, "rgba(255, 0, 0, 0.502)"},
+		{"fully transparent rgba", Color{color.RGBA{1, 2, 3, 0}}, ColorRGBA, "rgba(1, 2, 3, 0)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Format(tt.format); got != tt.want {
+				t.Errorf("Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColor_MarshalText_Alpha(t *testing.T) {
+	c := &Color{color.RGBA{255, 0, 0, 51}}
+
+	got, err := c.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v", err)
+	}
+	if string(got) != "#ff0000" {
+		t.Errorf("MarshalText() got = %s, want %s", got, "#ff0000")
+	}
+}
+
+func TestColor_Opacity(t *testing.T) {
+	c := Color{color.RGBA{255, 0, 0, 51}}
+
+	if got := c.Opacity(); got != O(0.2) {
+		t.Errorf("Opacity() = %v, want %v", got, O(0.2))
+	}
+	if got := c.Opaque(); got.A != 255 || got.R != 255 {
+		t.Errorf("Opaque() = %v", got)
+	}
+}
+
 func TestColor_UnmarshalText(t *testing.T) {
 	type fields struct {
 		RGBA color.RGBA
@@ -115,6 +161,27 @@ func TestColor_UnmarshalText(t *testing.T) {
 			Color{color.RGBA{255, 0, 0, 255}},
 			false,
 		},
+		{
+			"red with alpha",
+			fields{},
+			args{[]byte("#ff000080")},
+			Color{color.RGBA{255, 0, 0, 128}},
+			false,
+		},
+		{
+			"red function",
+			fields{},
+			args{[]byte(" rgb(255 0 0 / 50%) ")},
+			Color{color.RGBA{255, 0, 0, 128}},
+			false,
+		},
+		{
+			"transparent black function",
+			fields{},
+			args{[]byte("rgba(0, 0, 0, 0)")},
+			Color{},
+			false,
+		},
 		{
 			"red word",
 			fields{},
@@ -122,6 +189,13 @@ func TestColor_UnmarshalText(t *testing.T) {
 			Color{},
 			true,
 		},
+		{
+			"invalid function",
+			fields{},
+			args{[]byte("rgb(1, 2)")},
+			Color{},
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -160,6 +234,18 @@ func TestColorFromHexaString(t *testing.T) {
 			Color{color.RGBA{34, 85, 255, 255}},
 			false,
 		},
+		{
+			"short color code with alpha",
+			args{s: "#25f8"},
+			Color{color.RGBA{34, 85, 255, 136}},
+			false,
+		},
+		{
+			"long color code with alpha",
+			args{s: "#2255ff80"},
+			Color{color.RGBA{34, 85, 255, 128}},
+			false,
+		},
 		{
 			"invalid color code length",
 			args{s: "#2255ff8"},
@@ -172,6 +258,12 @@ func TestColorFromHexaString(t *testing.T) {
 			Color{},
 			true,
 		},
+		{
+			"invalid alpha char",
+			args{s: "#2255ffzz"},
+			Color{},
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,171 @@
+// Package colormap provides scientific colormaps and categorical palettes as svg.Color values
+// All colors are embedded as tables, nothing is fetched or computed from external sources at runtime.
+package colormap
+
+import (
+	"math"
+
+	svg "github.com/peteraba/go-svg"
+)
+
+// Colormap maps values between 0 and 1 to colors
+// The colors are stored as a table of evenly spaced samples, values between two samples are interpolated
+// along a Catmull-Rom spline in the CIE Lab color space, which follows the smooth curves the colormaps were
+// designed as much closer than straight lines in sRGB.
+type Colormap struct {
+	Name   string
+	colors []svg.Color
+}
+
+// At returns the Color of a Colormap at t, values outside of [0, 1] are clamped
+// NaN is treated as 0.
+func (cm Colormap) At(t float64) svg.Color {
+	if len(cm.colors) == 0 {
+		return svg.Color{}
+	}
+
+	if math.IsNaN(t) || t < 0 {
+		t = 0
+	} else if t > 1 {
+		t = 1
+	}
+
+	pos := t * float64(len(cm.colors)-1)
+	i := int(math.Floor(pos))
+	if i >= len(cm.colors)-1 {
+		return cm.colors[len(cm.colors)-1]
+	}
+
+	f := pos - float64(i)
+	if f == 0 {
+		return cm.colors[i]
+	}
+
+	return spline(cm.colors, i, f)
+}
+
+// Index returns the i-th of n colors evenly sampled from a Colormap, starting with the color at 0 and ending with the color at 1
+// Indexes outside of [0, n) are clamped.
+func (cm Colormap) Index(i, n int) svg.Color {
+	if n <= 1 {
+		return cm.At(0)
+	}
+
+	return cm.At(float64(i) / float64(n-1))
+}
+
+// Samples returns n colors evenly sampled from a Colormap
+func (cm Colormap) Samples(n int) []svg.Color {
+	res := make([]svg.Color, 0, n)
+	for i := 0; i < n; i++ {
+		res = append(res, cm.Index(i, n))
+	}
+
+	return res
+}
+
+// Reversed returns a Colormap running in the opposite direction
+func (cm Colormap) Reversed() Colormap {
+	colors := make([]svg.Color, len(cm.colors))
+	for i, c := range cm.colors {
+		colors[len(colors)-1-i] = c
+	}
+
+	return Colormap{Name: cm.Name + "_r", colors: colors}
+}
+
+// Gradient returns a horizontal LinearGradient approximating a Colormap with the given number of stops, e.g. for legends
+// At least two stops are used.
+func (cm Colormap) Gradient(id string, stops int) svg.LinearGradient {
+	if stops < 2 {
+		stops = 2
+	}
+
+	children := make([]interface{}, 0, stops)
+	for i := 0; i < stops; i++ {
+		t := float64(i) / float64(stops-1)
+		children = append(children, svg.St(math.Round(t*1e4)/1e4, cm.At(t)))
+	}
+
+	return svg.NewLinearGradient(id, children...)
+}
+
+// Palette is a list of distinct colors for categorical data
+type Palette struct {
+	Name   string
+	Colors []svg.Color
+}
+
+// At returns the i-th Color of a Palette, indexes beyond the size of the Palette wrap around
+func (p Palette) At(i int) svg.Color {
+	if len(p.Colors) == 0 {
+		return svg.Color{}
+	}
+
+	i %= len(p.Colors)
+	if i < 0 {
+		i += len(p.Colors)
+	}
+
+	return p.Colors[i]
+}
+
+// Len returns the number of colors in a Palette
+func (p Palette) Len() int {
+	return len(p.Colors)
+}
+
+// spline interpolates between colors[i] and colors[i+1] at f along a Catmull-Rom spline in CIE Lab
+// The neighbors beyond the ends of the table are mirrored, so the spline keeps its direction there.
+func spline(colors []svg.Color, i int, f float64) svg.Color {
+	p1, p2 := lab(colors[i]), lab(colors[i+1])
+
+	p0 := p1.mirror(p2)
+	if i > 0 {
+		p0 = lab(colors[i-1])
+	}
+
+	p3 := p2.mirror(p1)
+	if i+2 < len(colors) {
+		p3 = lab(colors[i+2])
+	}
+
+	var v labColor
+	for k := range v {
+		v[k] = 0.5 * (2*p1[k] +
+			(p2[k]-p0[k])*f +
+			(2*p0[k]-5*p1[k]+4*p2[k]-p3[k])*f*f +
+			(3*p1[k]-p0[k]-3*p2[k]+p3[k])*f*f*f)
+	}
+
+	return svg.ColorFromLab(v[0], v[1], v[2])
+}
+
+// labColor holds the L, a and b components of a color in the CIE Lab color space
+type labColor [3]float64
+
+func lab(c svg.Color) labColor {
+	l, a, b := c.ToLab()
+
+	return labColor{l, a, b}
+}
+
+// mirror returns the reflection of o through lc
+func (lc labColor) mirror(o labColor) labColor {
+	return labColor{2*lc[0] - o[0], 2*lc[1] - o[1], 2*lc[2] - o[2]}
+}
+
+// table converts hexadecimal color codes to colors, it panics on invalid codes as the tables are fixed
+func table(hexas ...string) []svg.Color {
+	res := make([]svg.Color, 0, len(hexas))
+
+	for _, h := range hexas {
+		c, err := svg.ColorFromHexaString(h)
+		if err != nil {
+			panic(err)
+		}
+		res = append(res, c)
+	}
+
+	return res
+}
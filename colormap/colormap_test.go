@@ -0,0 +1,185 @@
+package colormap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	svg "github.com/peteraba/go-svg"
+)
+
+func TestColormap_At(t *testing.T) {
+	tests := []struct {
+		name string
+		cm   Colormap
+		t    float64
+		want string
+	}{
+		{"viridis start", Viridis, 0, "#440154"},
+		{"viridis end", Viridis, 1, "#fde725"},
+		{"viridis sample", Viridis, 0.5, "#21908c"},
+		{"viridis interpolated", Viridis, 0.05, "#471566"},
+		{"below range", Magma, -1, "#000004"},
+		{"above range", Magma, 2, "#fcfdbf"},
+		{"not a number", Plasma, math.NaN(), "#0d0887"},
+		{"cividis end", Cividis, 1, "#ffea46"},
+		{"turbo middle", Turbo, 0.5, "#95fb51"},
+		{"rdbu middle", RdBu, 0.5, "#f7f7f7"},
+		{"reversed", Inferno.Reversed(), 0, "#fcffa4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cm.At(tt.t).String(); got != tt.want {
+				t.Errorf("At() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColormap_At_Reference(t *testing.T) {
+	// samples of the full 256 entry tables at points between the embedded samples
+	tests := []struct {
+		cm   Colormap
+		t    float64
+		want string
+	}{
+		{Viridis, 0.25, "#3b528b"},
+		{Viridis, 1.0 / 3, "#31688e"},
+		{Viridis, 2.0 / 3, "#35b779"},
+		{Viridis, 0.75, "#5ec962"},
+		{Magma, 0.25, "#51127c"},
+		{Magma, 1.0 / 3, "#721f81"},
+		{Magma, 2.0 / 3, "#f1605d"},
+		{Magma, 0.75, "#fc8961"},
+		{Inferno, 0.25, "#56106e"},
+		{Inferno, 1.0 / 3, "#781c6d"},
+		{Inferno, 2.0 / 3, "#ed6925"},
+		{Inferno, 0.75, "#f98e09"},
+		{Plasma, 0.25, "#7e03a8"},
+		{Plasma, 1.0 / 3, "#9c179e"},
+		{Plasma, 2.0 / 3, "#ed7953"},
+		{Plasma, 0.75, "#f89540"},
+		{Cividis, 0.25, "#414d6b"},
+		{Cividis, 0.5, "#7c7b78"},
+		{Cividis, 0.75, "#bcaf6f"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s at %.2f", tt.cm.Name, tt.t), func(t *testing.T) {
+			want, _ := svg.ColorFromHexaString(tt.want)
+			got := tt.cm.At(tt.t)
+
+			if d := deltaE(got, want); d > 1.5 {
+				t.Errorf("At() = %v, want %v, color difference %.2f", got, want, d)
+			}
+		})
+	}
+}
+
+// deltaE returns the CIE76 color difference of two colors, differences below about 2.3 are not noticeable
+func deltaE(c1, c2 svg.Color) float64 {
+	l1, a1, b1 := c1.ToLab()
+	l2, a2, b2 := c2.ToLab()
+
+	return math.Sqrt((l1-l2)*(l1-l2) + (a1-a2)*(a1-a2) + (b1-b2)*(b1-b2))
+}
+
+func TestColormap_Tables(t *testing.T) {
+	for _, cm := range []Colormap{Viridis, Magma, Inferno, Plasma, Cividis, Turbo, RdBu, PuOr} {
+		if len(cm.colors) < 2 {
+			t.Errorf("%s has %d colors", cm.Name, len(cm.colors))
+		}
+		for _, c := range cm.colors {
+			if c.A != 255 {
+				t.Errorf("%s has a transparent color: %v", cm.Name, c)
+			}
+		}
+	}
+}
+
+func TestColormap_Index(t *testing.T) {
+	samples := PuOr.Samples(3)
+	if len(samples) != 3 {
+		t.Fatalf("Samples() = %v, want 3 colors", samples)
+	}
+
+	want := []string{"#7f3b08", "#f7f7f7", "#2d004b"}
+	for i, c := range samples {
+		if c.String() != want[i] {
+			t.Errorf("Samples()[%d] = %v, want %v", i, c, want[i])
+		}
+		if got := PuOr.Index(i, 3); got != c {
+			t.Errorf("Index(%d, 3) = %v, want %v", i, got, c)
+		}
+	}
+
+	if got := PuOr.Index(5, 1); got != PuOr.At(0) {
+		t.Errorf("Index(5, 1) = %v, want %v", got, PuOr.At(0))
+	}
+
+	if got := (Colormap{}).At(0.5); got != (svg.Color{}) {
+		t.Errorf("empty Colormap At() = %v", got)
+	}
+}
+
+func TestColormap_Gradient(t *testing.T) {
+	lg := Viridis.Gradient("legend", 3)
+
+	gotBytes, err := xml.Marshal(lg)
+	if err != nil {
+		t.Fatalf("xml.Marshal() error = %v", err)
+	}
+
+	want := `<linearGradient id="legend">` +
+		`<stop offset="0" stop-color="#440154"></stop>` +
+		`<stop offset="0.5" stop-color="#21908c"></stop>` +
+		`<stop offset="1" stop-color="#fde725"></stop>` +
+		`</linearGradient>`
+	if got := string(gotBytes); got != want {
+		t.Errorf("xml.Marshal() got = %v, want %v", got, want)
+	}
+
+	if got := Viridis.Gradient("legend", 0); strings.Count(mustMarshal(t, got), "<stop") != 2 {
+		t.Errorf("Gradient() with too few stops = %v", mustMarshal(t, got))
+	}
+}
+
+func TestPalette_At(t *testing.T) {
+	tests := []struct {
+		name string
+		p    Palette
+		i    int
+		want string
+	}{
+		{"first", Tableau10, 0, "#4e79a7"},
+		{"last", Set1, 8, "#999999"},
+		{"wrap around", Set2, 8, "#66c2a5"},
+		{"negative", Paired, -1, "#b15928"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.At(tt.i).String(); got != tt.want {
+				t.Errorf("At() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if Tableau10.Len() != 10 || Set1.Len() != 9 || Set2.Len() != 8 || Paired.Len() != 12 {
+		t.Errorf("unexpected palette sizes")
+	}
+	if got := (Palette{}).At(3); got != (svg.Color{}) {
+		t.Errorf("empty Palette At() = %v", got)
+	}
+}
+
+func mustMarshal(t *testing.T, v interface{}) string {
+	t.Helper()
+
+	b, err := xml.Marshal(v)
+	if err != nil {
+		t.Fatalf("xml.Marshal() error = %v", err)
+	}
+
+	return string(b)
+}
@@ -0,0 +1,74 @@
+package colormap
+
+// Perceptually uniform sequential colormaps of matplotlib, sampled at 11 or 10 evenly spaced points
+// Interpolated values stay within a CIE76 color difference of 1.5 of the full 256 entry tables.
+// See: https://bids.github.io/colormap/
+var (
+	Viridis = Colormap{Name: "viridis", colors: table(
+		"#440154", "#482576", "#414487", "#35608d", "#2a788e", "#21908c",
+		"#22a884", "#43bf71", "#7ad151", "#bbdf27", "#fde725",
+	)}
+	Magma = Colormap{Name: "magma", colors: table(
+		"#000004", "#140e36", "#3b0f70", "#641a80", "#8c2981", "#b73779",
+		"#de4968", "#f7705c", "#fe9f6d", "#fecf92", "#fcfdbf",
+	)}
+	Inferno = Colormap{Name: "inferno", colors: table(
+		"#000004", "#160b39", "#420a68", "#6a176e", "#932667", "#bc3754",
+		"#dd513a", "#f37819", "#fca50a", "#f6d746", "#fcffa4",
+	)}
+	Plasma = Colormap{Name: "plasma", colors: table(
+		"#0d0887", "#41049d", "#6a00a8", "#8f0da4", "#b12a90", "#cc4678",
+		"#e16462", "#f2844b", "#fca636", "#fcce25", "#f0f921",
+	)}
+	Cividis = Colormap{Name: "cividis", colors: table(
+		"#00204d", "#00336f", "#39486b", "#575c6d", "#707173",
+		"#8a8779", "#a69d75", "#c4b56c", "#e4cf5b", "#ffea46",
+	)}
+)
+
+// Turbo is an improved rainbow colormap, sampled at 33 points from the polynomial approximation of d3-scale-chromatic
+// See: https://ai.googleblog.com/2019/08/turbo-improved-rainbow-colormap-for.html
+var Turbo = Colormap{Name: "turbo", colors: table(
+	"#23171b", "#3e2a71", "#493eae", "#4a53d7", "#4569ee", "#3c7ff8", "#3295f7", "#2ba9ef",
+	"#26bce1", "#25cdcf", "#29dcbc", "#32e9a7", "#3ff393", "#50f980", "#65fd6e", "#7cfd5e",
+	"#95fb51", "#adf545", "#c5ec3c", "#dae034", "#ecd12e", "#fac029", "#ffad24", "#ff9821",
+	"#ff821d", "#fd6c1a", "#f05616", "#df4111", "#cb2f0d", "#b61f07", "#a31302", "#950c00",
+	"#900c00",
+)}
+
+// Diverging colormaps of ColorBrewer, using the 11 class schemes
+// See: https://colorbrewer2.org
+var (
+	RdBu = Colormap{Name: "RdBu", colors: table(
+		"#67001f", "#b2182b", "#d6604d", "#f4a582", "#fddbc7", "#f7f7f7",
+		"#d1e5f0", "#92c5de", "#4393c3", "#2166ac", "#053061",
+	)}
+	PuOr = Colormap{Name: "PuOr", colors: table(
+		"#7f3b08", "#b35806", "#e08214", "#fdb863", "#fee0b6", "#f7f7f7",
+		"#d8daeb", "#b2abd2", "#8073ac", "#542788", "#2d004b",
+	)}
+)
+
+// Categorical palettes
+var (
+	// Tableau10 is the default categorical palette of Tableau since 2016
+	Tableau10 = Palette{Name: "Tableau10", Colors: table(
+		"#4e79a7", "#f28e2b", "#e15759", "#76b7b2", "#59a14f",
+		"#edc948", "#b07aa1", "#ff9da7", "#9c755f", "#bab0ac",
+	)}
+	// Set1 is the 9 class qualitative scheme of ColorBrewer
+	Set1 = Palette{Name: "Set1", Colors: table(
+		"#e41a1c", "#377eb8", "#4daf4a", "#984ea3", "#ff7f00",
+		"#ffff33", "#a65628", "#f781bf", "#999999",
+	)}
+	// Set2 is the 8 class qualitative scheme of ColorBrewer
+	Set2 = Palette{Name: "Set2", Colors: table(
+		"#66c2a5", "#fc8d62", "#8da0cb", "#e78ac3",
+		"#a6d854", "#ffd92f", "#e5c494", "#b3b3b3",
+	)}
+	// Paired is the 12 class qualitative scheme of ColorBrewer, consisting of light and dark pairs
+	Paired = Palette{Name: "Paired", Colors: table(
+		"#a6cee3", "#1f78b4", "#b2df8a", "#33a02c", "#fb9a99", "#e31a1c",
+		"#fdbf6f", "#ff7f00", "#cab2d6", "#6a3d9a", "#ffff99", "#b15928",
+	)}
+)
@@ -0,0 +1,403 @@
+package svg
+
+import (
+	"encoding"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const svgNamespace = "http://www.w3.org/2000/svg"
+
+// elementDecoders maps SVG tag names to constructors of the types they are decoded into
+// Tags not listed here are decoded into an Element
+var elementDecoders = map[string]func() xml.Unmarshaler{
+	"a":              func() xml.Unmarshaler { return &A{} },
+	"circle":         func() xml.Unmarshaler { return &Circle{} },
+	"defs":           func() xml.Unmarshaler { return &Defs{} },
+	"desc":           func() xml.Unmarshaler { return &Desc{} },
+	"ellipse":        func() xml.Unmarshaler { return &Ellipse{} },
+	"g":              func() xml.Unmarshaler { return &Group{} },
+	"line":           func() xml.Unmarshaler { return &Line{} },
+	"linearGradient": func() xml.Unmarshaler { return &LinearGradient{} },
+	"path":           func() xml.Unmarshaler { return &Path{} },
+	"polygon":        func() xml.Unmarshaler { return &Polygon{} },
+	"polyline":       func() xml.Unmarshaler { return &Polyline{} },
+	"radialGradient": func() xml.Unmarshaler { return &RadialGradient{} },
+	"rect":           func() xml.Unmarshaler { return &Rect{} },
+	"stop":           func() xml.Unmarshaler { return &Stop{} },
+	"svg":            func() xml.Unmarshaler { return &SVG{} },
+	"symbol":         func() xml.Unmarshaler { return &Symbol{} },
+	"text":           func() xml.Unmarshaler { return &Text{} },
+	"tspan":          func() xml.Unmarshaler { return &TSpan{} },
+	"use":            func() xml.Unmarshaler { return &Use{} },
+}
+
+// CharData represents text content found between child elements, e.g. inside a Text
+type CharData string
+
+// MarshalXML writes the escaped text content
+func (cd CharData) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	return e.EncodeToken(xml.CharData(cd))
+}
+
+// ParseSVG decodes an SVG document into the typed element tree
+func ParseSVG(r io.Reader) (SVG, error) {
+	var s SVG
+
+	err := xml.NewDecoder(r).Decode(&s)
+	if err != nil {
+		return SVG{}, err
+	}
+
+	return s, nil
+}
+
+// UnmarshalXML decodes an SVG element, keeping unknown attributes in Attrs
+func (s *SVG) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*s = NewSVG(0, 0)
+	s.Width, s.Height = nil, nil
+	s.Version = ""
+
+	err := decodeElement(d, start, s)
+	s.XMLName = xml.Name{Space: svgNamespace, Local: start.Name.Local}
+
+	return err
+}
+
+// UnmarshalXML decodes a Group element, keeping unknown attributes in Attrs
+func (g *Group) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*g = NewGroup()
+
+	return decodeElement(d, start, g)
+}
+
+// UnmarshalXML decodes an A element, keeping unknown attributes in Attrs
+func (a *A) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*a = NewA("")
+
+	return decodeElement(d, start, a)
+}
+
+// UnmarshalXML decodes a Circle element, keeping unknown attributes in Attrs
+func (c *Circle) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*c = NewCircle(nil, nil, nil)
+
+	return decodeElement(d, start, c)
+}
+
+// UnmarshalXML decodes an Ellipse element, keeping unknown attributes in Attrs
+func (el *Ellipse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*el = NewEllipse(nil, nil, nil, nil)
+
+	return decodeElement(d, start, el)
+}
+
+// UnmarshalXML decodes a Line element, keeping unknown attributes in Attrs
+func (l *Line) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*l = NewLine(nil, nil, nil, nil)
+
+	return decodeElement(d, start, l)
+}
+
+// UnmarshalXML decodes a Rect element, keeping unknown attributes in Attrs
+func (r *Rect) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*r = NewRect(nil, nil, nil, nil, nil, nil)
+
+	return decodeElement(d, start, r)
+}
+
+// UnmarshalXML decodes a Path element, keeping unknown attributes in Attrs
+func (p *Path) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*p = NewPath(nil)
+
+	return decodeElement(d, start, p)
+}
+
+// UnmarshalXML decodes a Polygon element, keeping unknown attributes in Attrs
+func (pg *Polygon) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*pg = NewPolygon(nil)
+
+	return decodeElement(d, start, pg)
+}
+
+// UnmarshalXML decodes a Polyline element, keeping unknown attributes in Attrs
+func (pl *Polyline) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*pl = NewPolyline(nil)
+
+	return decodeElement(d, start, pl)
+}
+
+// UnmarshalXML decodes a Defs element, keeping unknown attributes in Attrs
+func (de *Defs) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*de = NewDefs()
+
+	return decodeElement(d, start, de)
+}
+
+// UnmarshalXML decodes a Symbol element, keeping unknown attributes in Attrs
+func (s *Symbol) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*s = NewSymbol("")
+
+	return decodeElement(d, start, s)
+}
+
+// UnmarshalXML decodes a Use element, keeping unknown attributes in Attrs
+func (u *Use) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*u = NewUse("", nil, nil)
+
+	return decodeElement(d, start, u)
+}
+
+// UnmarshalXML decodes a LinearGradient element, keeping unknown attributes in Attrs
+func (lg *LinearGradient) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*lg = NewLinearGradient("")
+
+	return decodeElement(d, start, lg)
+}
+
+// UnmarshalXML decodes a RadialGradient element, keeping unknown attributes in Attrs
+func (rg *RadialGradient) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*rg = NewRadialGradient("")
+
+	return decodeElement(d, start, rg)
+}
+
+// UnmarshalXML decodes a Stop element, keeping unknown attributes in Attrs
+func (s *Stop) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*s = NewStop(nil, nil)
+
+	return decodeElement(d, start, s)
+}
+
+// UnmarshalXML decodes a Text element, keeping unknown attributes in Attrs
+func (t *Text) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*t = NewText(nil, nil)
+
+	return decodeElement(d, start, t)
+}
+
+// UnmarshalXML decodes a TSpan element, keeping its content as raw text
+func (ts *TSpan) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*ts = NewTSpan("")
+
+	return decodeElement(d, start, ts)
+}
+
+// UnmarshalXML decodes a Desc element, keeping its content as raw text
+func (de *Desc) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*de = NewDesc("")
+
+	return decodeElement(d, start, de)
+}
+
+// UnmarshalXML decodes any element, keeping its content as raw text
+func (e *Element) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*e = E(start.Name.Local, "", "", nil)
+
+	return decodeElement(d, start, e)
+}
+
+// decodeElement fills the fields of the struct v points to from an XML element
+// Attributes are matched against the xml struct tags of v, attributes which are
+// unknown or which can not be parsed are kept in the Attrs field. Child elements
+// are decoded into Children, unless v has an innerxml field which then receives
+// the raw content of the element instead.
+func decodeElement(d *xml.Decoder, start xml.StartElement, v interface{}) error {
+	rv := reflect.ValueOf(v).Elem()
+
+	if f := rv.FieldByName("XMLName"); f.IsValid() {
+		name := start.Name
+		if name.Space == svgNamespace {
+			name.Space = ""
+		}
+		f.Set(reflect.ValueOf(name))
+	}
+
+	fields := attrFields(rv)
+	attrs := rv.FieldByName("Attrs")
+
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+			continue
+		}
+
+		key := attr.Name.Local
+		if attr.Name.Space != "" {
+			key = attr.Name.Space + " " + key
+		}
+
+		if f, ok := fields[key]; ok && setAttrField(f, attr.Value) {
+			continue
+		}
+
+		if attrs.IsValid() {
+			attrs.Set(reflect.Append(attrs, reflect.ValueOf(attr)))
+		}
+	}
+
+	if inner, ok := innerXMLField(rv); ok {
+		raw := struct {
+			Inner string `xml:",innerxml"`
+		}{}
+
+		if err := d.DecodeElement(&raw, &start); err != nil {
+			return err
+		}
+
+		inner.SetString(raw.Inner)
+
+		return nil
+	}
+
+	children, err := decodeChildren(d)
+	if err != nil {
+		return err
+	}
+
+	if f := rv.FieldByName("Children"); f.IsValid() {
+		f.Set(reflect.ValueOf(children))
+	}
+
+	return nil
+}
+
+// decodeChildren decodes tokens until the end of the current element
+// Text content consisting only of whitespace, comments and processing instructions are dropped
+func decodeChildren(d *xml.Decoder) ([]interface{}, error) {
+	var children []interface{}
+
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			child, err := decodeChild(d, t)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, child)
+		case xml.CharData:
+			if strings.TrimSpace(string(t)) != "" {
+				children = append(children, CharData(t))
+			}
+		case xml.EndElement:
+			return children, nil
+		}
+	}
+}
+
+func decodeChild(d *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	newDecoder, ok := elementDecoders[start.Name.Local]
+	if !ok || (start.Name.Space != "" && start.Name.Space != svgNamespace) {
+		newDecoder = func() xml.Unmarshaler { return &Element{} }
+	}
+
+	u := newDecoder()
+	if err := u.UnmarshalXML(d, start); err != nil {
+		return nil, err
+	}
+
+	return reflect.ValueOf(u).Elem().Interface(), nil
+}
+
+// attrFields collects the settable attribute fields of a struct by their XML name
+// Namespaced names are keyed as "namespace local", like in struct tags.
+// Fields of embedded structs are collected as well
+func attrFields(rv reflect.Value) map[string]reflect.Value {
+	fields := map[string]reflect.Value{}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+			for name, f := range attrFields(rv.Field(i)) {
+				if _, ok := fields[name]; !ok {
+					fields[name] = f
+				}
+			}
+			continue
+		}
+
+		parts := strings.Split(sf.Tag.Get("xml"), ",")
+		if len(parts) < 2 || parts[0] == "" || parts[1] != "attr" {
+			continue
+		}
+
+		fields[parts[0]] = rv.Field(i)
+	}
+
+	return fields
+}
+
+func innerXMLField(rv reflect.Value) (reflect.Value, bool) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		if rt.Field(i).Tag.Get("xml") == ",innerxml" && rt.Field(i).Type.Kind() == reflect.String {
+			return rv.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// setAttrField parses value into the field f, reporting whether it succeeded
+func setAttrField(f reflect.Value, value string) bool {
+	target := f
+	if f.Kind() == reflect.Ptr {
+		target = reflect.New(f.Type().Elem()).Elem()
+	}
+
+	if u, ok := target.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText([]byte(value)); err != nil {
+			return false
+		}
+	} else if err := setBasicValue(target, value); err != nil {
+		return false
+	}
+
+	if f.Kind() == reflect.Ptr {
+		f.Set(target.Addr())
+	}
+
+	return true
+}
+
+func setBasicValue(v reflect.Value, value string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(strings.TrimSpace(value), v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(strings.TrimSpace(value), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(strings.TrimSpace(value), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	default:
+		return fmt.Errorf("unsupported attribute type: %s", v.Type())
+	}
+
+	return nil
+}
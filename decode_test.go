@@ -0,0 +1,222 @@
+package svg
+
+import (
+	"encoding/xml"
+	"image/color"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSVG_UnmarshalXML_roundTrip(t *testing.T) {
+	red := Color{color.RGBA{255, 0, 0, 255}}
+	navy := Color{color.RGBA{0, 0, 128, 255}}
+
+	tests := []struct {
+		name string
+		svg  SVG
+	}{
+		{
+			"empty svg",
+			NewSVG(200, 100),
+		},
+		{
+			"shapes",
+			NewSVG(
+				200,
+				100,
+				L(0, 30, 170, 30).SetStroke(red).SStrokeWidth(2),
+				C(10, 10, 5).SetTransform(NewTransform().Scale(2, 2)).SetFill(navy).SetFillOpacity(O(50, OPercent)).SetOpacity(0.5),
+				El(10, 10, 5, 3).SetStroke(red).SetStrokeDashArray(Lth(1), Lth(2, Px)).SetStrokeLinecap(LinecapRound),
+				NewRect(&Length{1, ""}, &Length{2, Em}, &Length{50, Percent}, &Length{3, ""}, &Length{1, ""}, nil),
+				P(NewPathData().MoveTo(1, 2).ArcToRel(3, 3, 0, true, false, 6, 0).ClosePath()),
+				Pg(Pts(0, 0, 10, 0, 10, 10)).SetFill(red),
+				Pl(Pts(0, 0, 10, 0, 10, 10)).SetStroke(red),
+			),
+		},
+		{
+			"nested containers",
+			NewSVG(
+				200,
+				100,
+				NewGroup(
+					NewA("https://example.com", C(1, 2, 3)).SetTarget("_blank"),
+					NewGroup(NewDesc("a <b>description</b>")).AddAttr("id", "inner"),
+				).AddAttr("class", "outer"),
+				T(0, 40, TS("foo")).SetTextAnchor(Middle).SetFill(red),
+				T(30, 40, TS("bar").SX(30).SDy(-2)).SetTextAnchor(Start).SetFill(navy),
+				E("x", "https://example.com/x", "lorem ipsum", map[string]string{"foo": "Foo"}, E("e", "", "merol muspi", nil)),
+			).AddAttr("viewBox", "0 0 200 100"),
+		},
+		{
+			"viewport",
+			NewSVG(0, 0, C(5, 5, 5)).SetWidth(Lth(100, Percent)).SetHeight(Lth(210, Mm)).
+				SetViewBox(VB(0, 0, 10, 10)).SetPreserveAspectRatio(PAR(AlignXMinYMax, Slice)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := xml.Marshal(tt.svg)
+			if err != nil {
+				t.Fatalf("xml.Marshal() error = %v", err)
+			}
+
+			var s SVG
+			if err := xml.Unmarshal(want, &s); err != nil {
+				t.Fatalf("xml.Unmarshal() error = %v", err)
+			}
+
+			got, err := xml.Marshal(s)
+			if err != nil {
+				t.Fatalf("xml.Marshal() error = %v", err)
+			}
+
+			if string(got) != string(want) {
+				t.Errorf("round trip got = %v, want %v", string(got), string(want))
+			}
+		})
+	}
+}
+
+func TestSVG_UnmarshalXML_alpha(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			"rgba fill",
+			`<rect width="1" height="1" fill="rgba(255,0,0,0.5)"></rect>`,
+			`<rect width="1" height="1" fill="rgba(255, 0, 0, 0.502)"></rect>`,
+		},
+		{
+			"hexa stroke",
+			`<line x2="1" stroke="#f008"></line>`,
+			`<line x2="1" stroke="#ff000088"></line>`,
+		},
+		{
+			"opaque fill",
+			`<rect width="1" height="1" fill="rgb(255,0,0)"></rect>`,
+			`<rect width="1" height="1" fill="#ff0000"></rect>`,
+		},
+		{
+			"stop color",
+			`<linearGradient id="g"><stop offset="0" stop-color="hsla(240,100%,50%,0.25)"></stop><stop offset="1" stop-color="#0000ff40"></stop></linearGradient>`,
+			`<linearGradient id="g"><stop offset="0" stop-color="rgba(0, 0, 255, 0.251)"></stop><stop offset="1" stop-color="#0000ff40"></stop></linearGradient>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s SVG
+			if err := xml.Unmarshal([]byte(`<svg xmlns="http://www.w3.org/2000/svg">`+tt.text+`</svg>`), &s); err != nil {
+				t.Fatalf("xml.Unmarshal() error = %v", err)
+			}
+
+			got, err := xml.Marshal(s.Children)
+			if err != nil {
+				t.Fatalf("xml.Marshal() error = %v", err)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("round trip got = %v, want %v", string(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSVG(t *testing.T) {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="200" height="100">
+	<!-- a comment -->
+	<g id="layer">
+		<circle cx="10" cy="20" r="5" fill="none" stroke="red" stroke-width="2" stroke-linecap="bogus"/>
+		<foo bar="baz">raw <b>content</b></foo>
+	</g>
+	<text x="5" y="10" text-anchor="end">Hello <tspan dy="1em">world</tspan></text>
+	<a xlink:href="#x"><rect width="10" height="10"/></a>
+</svg>`
+
+	s, err := ParseSVG(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("ParseSVG() error = %v", err)
+	}
+
+	if s.Width.Length != Lth(200) || s.Height.Length != Lth(100) {
+		t.Errorf("ParseSVG() size = %vx%v, want 200x100", s.Width, s.Height)
+	}
+	if len(s.Children) != 3 {
+		t.Fatalf("ParseSVG() children = %#v, want 3 children", s.Children)
+	}
+
+	g, ok := s.Children[0].(Group)
+	if !ok {
+		t.Fatalf("ParseSVG() first child = %T, want Group", s.Children[0])
+	}
+	if g.ID != "layer" || len(g.Attrs) != 0 {
+		t.Errorf("ParseSVG() group id = %v, attrs = %v", g.ID, g.Attrs)
+	}
+	if len(g.Children) != 2 {
+		t.Fatalf("ParseSVG() group children = %#v, want 2 children", g.Children)
+	}
+
+	c, ok := g.Children[0].(Circle)
+	if !ok {
+		t.Fatalf("ParseSVG() circle = %T, want Circle", g.Children[0])
+	}
+	if c.CX.Length != (Length{10, ""}) || c.CY.Length != (Length{20, ""}) || c.R.Length != (Length{5, ""}) {
+		t.Errorf("ParseSVG() circle = %v %v %v", c.CX, c.CY, c.R)
+	}
+	if c.Stroke == nil || c.Stroke.Color.RGBA != (color.RGBA{255, 0, 0, 255}) {
+		t.Errorf("ParseSVG() circle stroke = %v", c.Stroke)
+	}
+	if c.StrokeWidth == nil || *c.StrokeWidth != (Length{2, ""}) {
+		t.Errorf("ParseSVG() circle stroke width = %v", c.StrokeWidth)
+	}
+	if c.Fill == nil || *c.Fill != NonePaint() {
+		t.Errorf("ParseSVG() circle fill = %v", c.Fill)
+	}
+
+	if c.StrokeLinecap != nil || !reflect.DeepEqual(c.Attrs, []xml.Attr{{Name: xml.Name{Local: "stroke-linecap"}, Value: "bogus"}}) {
+		t.Errorf("ParseSVG() unparsable stroke-linecap should be kept in attrs, got %v %v", c.StrokeLinecap, c.Attrs)
+	}
+
+	e, ok := g.Children[1].(Element)
+	if !ok {
+		t.Fatalf("ParseSVG() unknown element = %T, want Element", g.Children[1])
+	}
+	if e.XMLName.Local != "foo" || e.Text != "raw <b>content</b>" {
+		t.Errorf("ParseSVG() unknown element = %v %v", e.XMLName, e.Text)
+	}
+
+	text, ok := s.Children[1].(Text)
+	if !ok {
+		t.Fatalf("ParseSVG() text = %T, want Text", s.Children[1])
+	}
+	if text.TextAnchor == nil || *text.TextAnchor != End {
+		t.Errorf("ParseSVG() text anchor = %v", text.TextAnchor)
+	}
+	if len(text.Children) != 2 || text.Children[0] != CharData("Hello ") {
+		t.Fatalf("ParseSVG() text children = %#v", text.Children)
+	}
+	if ts, ok := text.Children[1].(TSpan); !ok || ts.Text != "world" || *ts.DY != (Length{1, Em}) {
+		t.Errorf("ParseSVG() tspan = %#v", text.Children[1])
+	}
+
+	a, ok := s.Children[2].(A)
+	if !ok {
+		t.Fatalf("ParseSVG() a = %T, want A", s.Children[2])
+	}
+	if a.Href != "" || len(a.Attrs) != 1 || a.Attrs[0].Value != "#x" {
+		t.Errorf("ParseSVG() namespaced href should be kept in attrs, got %v %v", a.Href, a.Attrs)
+	}
+	if _, ok := a.Children[0].(Rect); !ok {
+		t.Errorf("ParseSVG() a child = %T, want Rect", a.Children[0])
+	}
+}
+
+func TestParseSVG_invalid(t *testing.T) {
+	_, err := ParseSVG(strings.NewReader(`<svg><circle></svg>`))
+	if err == nil {
+		t.Errorf("ParseSVG() error = %v, wantErr %v", err, true)
+	}
+}
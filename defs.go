@@ -0,0 +1,51 @@
+package svg
+
+import (
+	"encoding/xml"
+	"sync"
+)
+
+// Defs represents a Defs SVG element
+// See: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/defs
+type Defs struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",attr"`
+	Children []interface{}
+	lock     *sync.Mutex
+}
+
+// NewDefs constructs new Defs element
+func NewDefs(children ...interface{}) Defs {
+	d := Defs{
+		XMLName: xml.Name{Local: "defs"},
+		lock:    &sync.Mutex{},
+	}
+
+	d.Children = append(d.Children, children...)
+
+	return d
+}
+
+// AddAttr adds a new attribute of a Defs
+func (d Defs) AddAttr(name, value string) Defs {
+	d.lock.Lock()
+	d.Attrs = append(d.Attrs, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+	d.lock.Unlock()
+
+	return d
+}
+
+// RemoveAttr removes all attributes of a given name of a Defs
+func (d Defs) RemoveAttr(name string) Defs {
+	d.lock.Lock()
+	var attrs []xml.Attr
+	for _, attr := range d.Attrs {
+		if attr.Name.Local != name {
+			attrs = append(attrs, attr)
+		}
+	}
+	d.Attrs = attrs
+	d.lock.Unlock()
+
+	return d
+}
@@ -0,0 +1,70 @@
+package svg
+
+import (
+	"encoding/xml"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestNewDefs(t *testing.T) {
+	tests := []struct {
+		name     string
+		children []interface{}
+		want     Defs
+	}{
+		{
+			"empty defs",
+			nil,
+			Defs{XMLName: xml.Name{Local: "defs"}, lock: &sync.Mutex{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewDefs(tt.children...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewDefs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefs_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		d       Defs
+		want    string
+		wantErr bool
+	}{
+		{
+			"empty defs",
+			NewDefs(),
+			`<defs></defs>`,
+			false,
+		},
+		{
+			"defs with children",
+			NewDefs(NewGroup(C(1, 2, 3)).SetID("dot")),
+			`<defs><g id="dot"><circle cx="1" cy="2" r="3"></circle></g></defs>`,
+			false,
+		},
+		{
+			"defs with attribute",
+			NewDefs().AddAttr("foo", "Foo").AddAttr("bar", "Bar").RemoveAttr("foo"),
+			`<defs bar="Bar"></defs>`,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBytes, err := xml.Marshal(tt.d)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("xml.Marshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := string(gotBytes)
+			if got != tt.want {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
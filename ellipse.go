@@ -8,20 +8,16 @@ import (
 // Ellipse represents a Ellipse SVG element
 // See: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/ellipse
 type Ellipse struct {
-	XMLName       xml.Name
-	CX            *Length    `xml:"cx,attr,omitempty"`
-	CY            *Length    `xml:"cy,attr,omitempty"`
-	RX            *Length    `xml:"rx,attr,omitempty"`
-	RY            *Length    `xml:"ry,attr,omitempty"`
-	Stroke        *Color     `xml:"stroke,attr,omitempty"`
-	StrokeWidth   *uint8     `xml:"stroke-width,attr,omitempty"`
-	StrokeOpacity *Opacity   `xml:"stroke-opacity,attr,omitempty"`
-	Fill          *Color     `xml:"fill,attr,omitempty"`
-	FillOpacity   *Opacity   `xml:"fill-opacity,attr,omitempty"`
-	Opacity       float64    `xml:"opacity,attr,omitempty"`
-	Attrs         []xml.Attr `xml:",attr"`
-	Children      []interface{}
-	lock          *sync.Mutex
+	XMLName   xml.Name
+	CX        *LengthExpr `xml:"cx,attr,omitempty"`
+	CY        *LengthExpr `xml:"cy,attr,omitempty"`
+	RX        *LengthExpr `xml:"rx,attr,omitempty"`
+	RY        *LengthExpr `xml:"ry,attr,omitempty"`
+	Transform *Transform  `xml:"transform,attr,omitempty"`
+	Presentation
+	Attrs    []xml.Attr `xml:",attr"`
+	Children []interface{}
+	lock     *sync.Mutex
 }
 
 // El constructs new Ellipse element (shortcut)
@@ -59,10 +55,10 @@ func El(cx, cy, rx, ry float64, children ...interface{}) Ellipse {
 func NewEllipse(cx, cy, rx, ry *Length, children ...interface{}) Ellipse {
 	c := Ellipse{
 		XMLName: xml.Name{Local: "ellipse"},
-		CX:      cx,
-		CY:      cy,
-		RX:      rx,
-		RY:      ry,
+		CX:      exprOf(cx),
+		CY:      exprOf(cy),
+		RX:      exprOf(rx),
+		RY:      exprOf(ry),
 		lock:    &sync.Mutex{},
 	}
 
@@ -71,85 +67,16 @@ func NewEllipse(cx, cy, rx, ry *Length, children ...interface{}) Ellipse {
 	return c
 }
 
-// SetStrokeWidth sets the stroke width of a Ellipse
-func (el Ellipse) SetStrokeWidth(strokeWidth uint8) Ellipse {
-	el.StrokeWidth = &strokeWidth
+// SetTransform sets the transform of an Ellipse
+func (el Ellipse) SetTransform(transform Transform) Ellipse {
+	el.Transform = &transform
 
 	return el
 }
 
-// UnsetStrokeWidth removes the previously set stroke width of a Ellipse
-func (el Ellipse) UnsetStrokeWidth() Ellipse {
-	el.StrokeWidth = nil
-
-	return el
-}
-
-// SetStroke sets the stroke color of a Ellipse
-func (el Ellipse) SetStroke(stroke Color) Ellipse {
-	el.Stroke = &stroke
-
-	return el
-}
-
-// UnsetStroke removes the previously set stroke color of a Ellipse
-func (el Ellipse) UnsetStroke() Ellipse {
-	el.Stroke = nil
-
-	return el
-}
-
-// SetStrokeOpacity sets the stroke opacity of a Ellipse
-func (el Ellipse) SetStrokeOpacity(so Opacity) Ellipse {
-	el.StrokeOpacity = &so
-
-	return el
-}
-
-// SetStrokeOpacity removes the stroke opacity of a Ellipse
-func (el Ellipse) UnsetStrokeOpacity() Ellipse {
-	el.StrokeOpacity = nil
-
-	return el
-}
-
-// SetStroke sets the fill color of a Ellipse
-func (el Ellipse) SetFill(fill Color) Ellipse {
-	el.Fill = &fill
-
-	return el
-}
-
-// UnsetStroke removes the previously set fill color of a Ellipse
-func (el Ellipse) UnsetFill() Ellipse {
-	el.Fill = nil
-
-	return el
-}
-
-// SetStrokeOpacity sets the fill opacity of a Ellipse
-func (el Ellipse) SetFillOpacity(fo Opacity) Ellipse {
-	el.FillOpacity = &fo
-
-	return el
-}
-
-// SetStrokeOpacity removes the stroke opacity of a Ellipse
-func (el Ellipse) UnsetFillOpacity() Ellipse {
-	el.FillOpacity = nil
-
-	return el
-}
-
-// SetOpacity sets the opacity of a Ellipse
-func (el Ellipse) SetOpacity(o float64) Ellipse {
-	if o < 0 {
-		el.Opacity = 0
-	} else if o > 1 {
-		el.Opacity = 1
-	} else {
-		el.Opacity = o
-	}
+// UnsetTransform removes the previously set transform of an Ellipse
+func (el Ellipse) UnsetTransform() Ellipse {
+	el.Transform = nil
 
 	return el
 }
@@ -177,3 +104,14 @@ func (el Ellipse) RemoveAttr(name string) Ellipse {
 
 	return el
 }
+
+// BBox returns the bounding box of the fill area of an Ellipse, without its transform
+// Percentages are resolved against an empty viewport, use Geometry for other viewports.
+func (el Ellipse) BBox() BBox {
+	return defaultGeometry.BBox(el)
+}
+
+// StrokeBBox returns the bounding box of an Ellipse including its stroke, without its transform
+func (el Ellipse) StrokeBBox() BBox {
+	return defaultGeometry.StrokeBBox(el)
+}
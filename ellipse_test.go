@@ -24,7 +24,7 @@ func TestEl(t *testing.T) {
 		{
 			"simple ellipse",
 			args{cx: 1, cy: 2, rx: 4.2, ry: 3.1},
-			Ellipse{XMLName: xml.Name{Local: "ellipse"}, CX: &Length{Number: 1}, CY: &Length{Number: 2}, RX: &Length{Number: 4.2}, RY: &Length{Number: 3.1}, lock: &sync.Mutex{}},
+			Ellipse{XMLName: xml.Name{Local: "ellipse"}, CX: exprOf(&Length{Number: 1}), CY: exprOf(&Length{Number: 2}), RX: exprOf(&Length{Number: 4.2}), RY: exprOf(&Length{Number: 3.1}), lock: &sync.Mutex{}},
 		},
 	}
 	for _, tt := range tests {
@@ -52,7 +52,7 @@ func TestNewEllipse(t *testing.T) {
 		{
 			"simple ellipse",
 			args{cx: &Length{Number: 1}, cy: &Length{Number: 2}, rx: &Length{Number: 4.2}, ry: &Length{Number: 3.1}},
-			Ellipse{XMLName: xml.Name{Local: "ellipse"}, CX: &Length{Number: 1}, CY: &Length{Number: 2}, RX: &Length{Number: 4.2}, RY: &Length{Number: 3.1}, lock: &sync.Mutex{}},
+			Ellipse{XMLName: xml.Name{Local: "ellipse"}, CX: exprOf(&Length{Number: 1}), CY: exprOf(&Length{Number: 2}), RX: exprOf(&Length{Number: 4.2}), RY: exprOf(&Length{Number: 3.1}), lock: &sync.Mutex{}},
 		},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,166 @@
+package svg
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// Encoder writes an SVG document element by element, without building the whole tree in memory
+// Container elements, e.g. SVG, Group, A and Text, are written with Open and Close, their Children are
+// ignored and everything encoded in between becomes their content. Other elements are written with Encode.
+// Output is buffered, it is written to the underlying writer whenever the buffer fills up and on Flush.
+//
+//	enc := NewEncoder(w)
+//	_ = enc.Open(NewSVG(200, 100))
+//	_ = enc.Encode(C(10, 10, 5))
+//	err := enc.End()
+type Encoder struct {
+	w   io.Writer
+	bw  *bufio.Writer
+	buf bytes.Buffer
+	xe  *xml.Encoder
+	// open holds the tag names of the open elements, the innermost one is last
+	open []string
+	// err is the first error which occurred, all later calls return it
+	err error
+}
+
+// NewEncoder constructs new Encoder writing to w
+func NewEncoder(w io.Writer) *Encoder {
+	e := &Encoder{w: w, bw: bufio.NewWriter(w)}
+	e.xe = xml.NewEncoder(&e.buf)
+
+	return e
+}
+
+// Header writes the standard XML header, it must be called before anything else
+func (e *Encoder) Header() error {
+	return e.write([]byte(xml.Header))
+}
+
+// Open writes the start tag of a container element, its children are not written
+func (e *Encoder) Open(element interface{}) error {
+	if e.err != nil {
+		return e.err
+	}
+
+	v := reflect.ValueOf(element)
+	if v.Kind() != reflect.Struct || !v.FieldByName("Children").IsValid() || !v.FieldByName("XMLName").IsValid() {
+		e.err = fmt.Errorf("svg: can not open %T, it is not a container element", element)
+
+		return e.err
+	}
+
+	// marshal a copy without children, which results in an empty element, e.g. <g id="x"></g>
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	c.FieldByName("Children").Set(reflect.Zero(c.FieldByName("Children").Type()))
+
+	b, err := e.marshal(c.Interface())
+	if err != nil {
+		return err
+	}
+
+	name := c.FieldByName("XMLName").Interface().(xml.Name).Local
+	end := []byte("</" + name + ">")
+	if !bytes.HasSuffix(b, end) {
+		e.err = fmt.Errorf("svg: can not open %T, it has content besides its children", element)
+
+		return e.err
+	}
+
+	if err := e.write(b[:len(b)-len(end)]); err != nil {
+		return err
+	}
+
+	e.open = append(e.open, name)
+
+	return nil
+}
+
+// Close writes the end tag of the innermost open element
+func (e *Encoder) Close() error {
+	if e.err != nil {
+		return e.err
+	}
+
+	if len(e.open) == 0 {
+		e.err = errors.New("svg: no open element to close")
+
+		return e.err
+	}
+
+	name := e.open[len(e.open)-1]
+	e.open = e.open[:len(e.open)-1]
+
+	return e.write([]byte("</" + name + ">"))
+}
+
+// Encode writes a complete element including its children, e.g. a Circle or CharData inside of a Text
+func (e *Encoder) Encode(element interface{}) error {
+	b, err := e.marshal(element)
+	if err != nil {
+		return err
+	}
+
+	return e.write(b)
+}
+
+// Flush writes the buffered output to the underlying writer
+// If the writer can be flushed itself, e.g. it is an http.ResponseWriter, it is flushed as well.
+func (e *Encoder) Flush() error {
+	if e.err != nil {
+		return e.err
+	}
+
+	if e.err = e.bw.Flush(); e.err != nil {
+		return e.err
+	}
+
+	if f, ok := e.w.(interface{ Flush() }); ok {
+		f.Flush()
+	}
+
+	return nil
+}
+
+// End closes all open elements and flushes the output
+func (e *Encoder) End() error {
+	for len(e.open) > 0 {
+		if err := e.Close(); err != nil {
+			return err
+		}
+	}
+
+	return e.Flush()
+}
+
+// marshal marshals a single element with the same rules xml.Marshal uses, reusing the buffer of the Encoder
+func (e *Encoder) marshal(element interface{}) ([]byte, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	e.buf.Reset()
+
+	if e.err = e.xe.Encode(element); e.err != nil {
+		return nil, e.err
+	}
+
+	return e.buf.Bytes(), nil
+}
+
+func (e *Encoder) write(b []byte) error {
+	if e.err != nil {
+		return e.err
+	}
+
+	_, e.err = e.bw.Write(b)
+
+	return e.err
+}
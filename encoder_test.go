@@ -0,0 +1,122 @@
+package svg
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEncoder(t *testing.T) {
+	var buf bytes.Buffer
+
+	enc := NewEncoder(&buf)
+
+	steps := []func() error{
+		enc.Header,
+		func() error { return enc.Open(NewSVG(200, 100, C(1, 1, 1)).SetViewBox(VB(0, 0, 20, 10))) },
+		func() error { return enc.Open(NewGroup().SetID("points").SetTransform(NewTransform().Scale(2, 2))) },
+		func() error { return enc.Encode(C(1, 2, 3)) },
+		func() error { return enc.Encode(R(1, 2, 3, 4)) },
+		enc.Close,
+		func() error { return enc.Open(NewA("https://example.com")) },
+		func() error { return enc.Open(T(0, 10).SetTextAnchor(Middle)) },
+		func() error { return enc.Encode(CharData("a < b ")) },
+		func() error { return enc.Encode(TS("c")) },
+		enc.End,
+	}
+	for i, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("step %d error = %v", i, err)
+		}
+	}
+
+	want := strings.Join([]string{
+		xml.Header,
+		`<svg xmlns="http://www.w3.org/2000/svg" width="200" height="100" version="1.1" viewBox="0 0 20 10">`,
+		`<g transform="scale(2 2)" id="points">`,
+		`<circle cx="1" cy="2" r="3"></circle>`,
+		`<rect x="1" y="2" width="3" height="4"></rect>`,
+		`</g>`,
+		`<a href="https://example.com">`,
+		`<text y="10" text-anchor="middle">a &lt; b <tspan>c</tspan></text>`,
+		`</a>`,
+		`</svg>`,
+	}, "")
+	if got := buf.String(); got != want {
+		t.Errorf("Encoder got = %v, want %v", got, want)
+	}
+
+	s, err := ParseSVG(strings.NewReader(buf.String()))
+	if err != nil {
+		t.Fatalf("ParseSVG() error = %v", err)
+	}
+	if len(s.Children) != 2 {
+		t.Errorf("ParseSVG() children = %#v, want 2 children", s.Children)
+	}
+}
+
+type flushRecorder struct {
+	bytes.Buffer
+	flushes int
+}
+
+func (fr *flushRecorder) Flush() {
+	fr.flushes++
+}
+
+func TestEncoder_Flush(t *testing.T) {
+	var w flushRecorder
+
+	enc := NewEncoder(&w)
+	_ = enc.Open(NewSVG(10, 10))
+
+	if w.Len() != 0 {
+		t.Errorf("output is not buffered, got %q", w.String())
+	}
+
+	for i := 0; i < 1000; i++ {
+		_ = enc.Encode(C(1, 1, 1))
+	}
+	if w.Len() == 0 {
+		t.Errorf("output is not written when the buffer is full")
+	}
+
+	if err := enc.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	if w.flushes != 1 {
+		t.Errorf("Flush() flushed the writer %d times, want 1", w.flushes)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("boom")
+}
+
+func TestEncoder_errors(t *testing.T) {
+	enc := NewEncoder(&bytes.Buffer{})
+	if err := enc.Close(); err == nil {
+		t.Errorf("Close() error = %v, wantErr %v", err, true)
+	}
+	if err := enc.Encode(C(1, 1, 1)); err == nil {
+		t.Errorf("errors are not sticky, Encode() error = %v", err)
+	}
+
+	enc = NewEncoder(&bytes.Buffer{})
+	if err := enc.Open(C(1, 1, 1)); err != nil {
+		t.Errorf("Open() error = %v", err)
+	}
+	if err := NewEncoder(&bytes.Buffer{}).Open(Color{}); err == nil {
+		t.Errorf("Open() of a non element error = %v, wantErr %v", err, true)
+	}
+
+	enc = NewEncoder(failingWriter{})
+	_ = enc.Open(NewSVG(1, 1))
+	if err := enc.End(); err == nil {
+		t.Errorf("End() error = %v, wantErr %v", err, true)
+	}
+}
@@ -0,0 +1,178 @@
+package svg
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// WriteEPS writes an SVG tag to w as an Encapsulated PostScript (Level 2) file, e.g. to include it in LaTeX
+// The bounding box is the viewport of the SVG. Shapes are written as vector paths with their colors,
+// transforms and stroke styles. PostScript does not support transparency, so opacities are ignored,
+// only fully transparent paint is left out. Text is set in Helvetica with the default font size.
+func WriteEPS(w io.Writer, s SVG) error {
+	width, height := s.intrinsicSize()
+	if width <= 0 || height <= 0 {
+		return errors.New("svg: can not write EPS, the SVG has no size")
+	}
+
+	ec := newEPSContent()
+
+	// PostScript user space is measured in points with the y axis pointing upwards
+	ec.PushTransform(Matrix{A: pointsPerPixel, D: -pointsPerPixel, F: height * pointsPerPixel})
+
+	if err := Render(s, ec, NewLengthResolver(width, height)); err != nil {
+		return err
+	}
+
+	ec.PopTransform()
+
+	return ec.writeDocument(w, width*pointsPerPixel, height*pointsPerPixel)
+}
+
+// epsContent is the Renderer collecting the PostScript program drawing the page
+type epsContent struct {
+	psBuffer
+	font  bool
+	paint PaintStyle
+}
+
+func newEPSContent() *epsContent {
+	return &epsContent{}
+}
+
+func (ec *epsContent) FontMetrics() FontMetrics {
+	return NewHelveticaMetrics(DefaultFontSize)
+}
+
+func (ec *epsContent) PushTransform(m Matrix) {
+	ec.op("gsave")
+	if m != IdentityMatrix() {
+		ec.op("concat", "["+joinNumbers([]float64{m.A, m.B, m.C, m.D, m.E, m.F}, psNumber)+"]")
+	}
+}
+
+func (ec *epsContent) PopTransform() {
+	ec.op("grestore")
+}
+
+func (ec *epsContent) SetPaint(ps PaintStyle) {
+	ec.paint = ps
+}
+
+func (ec *epsContent) FillPath(pd PathData) {
+	ps := ec.paint
+	if ps.Fill == nil {
+		return
+	}
+
+	ec.op("gsave")
+	ec.path(pd)
+	ec.op("setrgbcolor", rgb(*ps.Fill)...)
+
+	if ps.FillRule == FillRuleEvenOdd {
+		ec.op("eofill")
+	} else {
+		ec.op("fill")
+	}
+
+	ec.op("grestore")
+}
+
+func (ec *epsContent) StrokePath(pd PathData) {
+	ps := ec.paint
+	if ps.Stroke == nil {
+		return
+	}
+
+	ec.op("gsave")
+	ec.path(pd)
+	ec.op("setrgbcolor", rgb(*ps.Stroke)...)
+	ec.op("setlinewidth", ps.StrokeWidth)
+	ec.op("setlinecap", linecapCode(ps.Linecap))
+	ec.op("setlinejoin", linejoinCode(ps.Linejoin))
+	ec.op("setmiterlimit", ps.Miterlimit)
+	if len(ps.Dashes) > 0 {
+		ec.op("setdash", "["+joinNumbers(ps.Dashes, psNumber)+"]", ps.DashOffset)
+	}
+	ec.op("stroke")
+	ec.op("grestore")
+}
+
+// path writes a new path constructed from a normalized PathData
+func (ec *epsContent) path(pd PathData) {
+	ec.op("newpath")
+
+	forEachSegment(pd, pathSink{
+		moveTo:  func(x, y float64) { ec.op("moveto", x, y) },
+		lineTo:  func(x, y float64) { ec.op("lineto", x, y) },
+		curveTo: func(x1, y1, x2, y2, x, y float64) { ec.op("curveto", x1, y1, x2, y2, x, y) },
+		close:   func() { ec.op("closepath") },
+	})
+}
+
+func (ec *epsContent) DrawText(x, y float64, text string, anchor TextAnchor) {
+	if ec.paint.Fill == nil {
+		return
+	}
+
+	ec.font = true
+	x = anchorStart(x, ec.FontMetrics().Advance(text), anchor)
+
+	ec.op("gsave")
+	ec.op("setrgbcolor", rgb(*ec.paint.Fill)...)
+	ec.op("selectfont", "/F1", DefaultFontSize)
+	ec.op("translate", x, y)
+	// the y axis points downwards in SVG user space, so glyphs are flipped back
+	ec.op("scale", 1.0, -1.0)
+	ec.op("moveto", 0.0, 0.0)
+	ec.op("show", psString(text))
+	ec.op("grestore")
+}
+
+// PushLayer does nothing, as PostScript does not support transparency
+func (ec *epsContent) PushLayer(float64) {}
+
+func (ec *epsContent) PopLayer() {}
+
+func (ec *epsContent) Clip(pd PathData, rule FillRule) {
+	ec.path(pd)
+
+	if rule == FillRuleEvenOdd {
+		ec.op("eoclip")
+	} else {
+		ec.op("clip")
+	}
+
+	ec.op("newpath")
+}
+
+// writeDocument writes the complete EPS file with a bounding box of width x height points
+func (ec *epsContent) writeDocument(w io.Writer, width, height float64) error {
+	var buf psBuffer
+
+	buf.WriteString("%!PS-Adobe-3.0 EPSF-3.0\n")
+	fmt.Fprintf(&buf, "%%%%BoundingBox: 0 0 %d %d\n", int(math.Ceil(width)), int(math.Ceil(height)))
+	fmt.Fprintf(&buf, "%%%%HiResBoundingBox: 0 0 %s %s\n", psNumber(width), psNumber(height))
+	buf.WriteString("%%LanguageLevel: 2\n")
+	buf.WriteString("%%Pages: 1\n")
+	buf.WriteString("%%EndComments\n")
+
+	if ec.font {
+		// Helvetica is re-encoded, as the standard encoding of PostScript lacks most accented letters
+		buf.WriteString("%%BeginProlog\n")
+		buf.WriteString("/F1 /Helvetica findfont dup length dict begin\n")
+		buf.WriteString("{ 1 index /FID ne { def } { pop pop } ifelse } forall\n")
+		buf.WriteString("/Encoding ISOLatin1Encoding def currentdict end definefont pop\n")
+		buf.WriteString("%%EndProlog\n")
+	}
+
+	buf.WriteString("%%Page: 1 1\n")
+	buf.Write(ec.Bytes())
+	buf.WriteString("showpage\n%%EOF\n")
+
+	_, err := w.Write(buf.Bytes())
+
+	return err
+}
@@ -0,0 +1,113 @@
+package svg
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestWriteEPS(t *testing.T) {
+	red := Red.ToColor()
+
+	tests := []struct {
+		name string
+		s    SVG
+		want []string
+	}{
+		{
+			"header",
+			NewSVG(100, 50.5),
+			[]string{
+				"%!PS-Adobe-3.0 EPSF-3.0\n%%BoundingBox: 0 0 75 38\n%%HiResBoundingBox: 0 0 75 37.875\n%%LanguageLevel: 2\n",
+				"gsave\n[0.75 0 0 -0.75 0 37.875] concat\ngrestore\nshowpage\n%%EOF\n",
+			},
+		},
+		{
+			"bounding box of viewBox",
+			NewSVG(0, 0).SetViewBox(VB(0, 0, 40, 20)),
+			[]string{"%%BoundingBox: 0 0 30 15\n"},
+		},
+		{
+			"fill and stroke",
+			NewSVG(100, 50, R(1, 2, 3, 4).SetFill(red).SetStroke(Blue.ToColor()).SetStrokeDashArray(Lth(2))),
+			[]string{strings.Join([]string{
+				"gsave",
+				"newpath",
+				"1 2 moveto",
+				"4 2 lineto",
+				"4 6 lineto",
+				"1 6 lineto",
+				"closepath",
+				"1 0 0 setrgbcolor",
+				"fill",
+				"grestore",
+				"gsave",
+				"newpath",
+				"1 2 moveto",
+				"4 2 lineto",
+				"4 6 lineto",
+				"1 6 lineto",
+				"closepath",
+				"0 0 1 setrgbcolor",
+				"1 setlinewidth",
+				"0 setlinecap",
+				"0 setlinejoin",
+				"4 setmiterlimit",
+				"[2 2] 0 setdash",
+				"stroke",
+				"grestore",
+			}, "\n")},
+		},
+		{
+			"even-odd",
+			NewSVG(100, 50, Pg(Points{{0, 0}, {1, 0}, {0, 1}}).SetFillRule(FillRuleEvenOdd)),
+			[]string{"0 0 0 setrgbcolor\neofill\n"},
+		},
+		{
+			"group transform",
+			NewSVG(100, 50, NewGroup(R(0, 0, 1, 1)).SetTransform(NewTransform().Rotate(90))),
+			[]string{"gsave\n[0 1 -1 0 0 0] concat\ngsave\nnewpath\n"},
+		},
+		{
+			"text",
+			NewSVG(100, 50, T(10, 20, CharData("Hi "), TS("é"))),
+			[]string{
+				"%%BeginProlog\n/F1 /Helvetica findfont",
+				"gsave\n0 0 0 setrgbcolor\n/F1 16 selectfont\n10 20 translate\n1 -1 scale\n0 0 moveto\n(Hi ) show\ngrestore\n",
+				"(\\351) show\n",
+			},
+		},
+		{
+			"text anchor",
+			NewSVG(100, 50, T(10, 20, CharData("Hi")).SetTextAnchor(End)),
+			[]string{"-5.104 20 translate\n"},
+		},
+		{
+			"symbol viewport clip",
+			NewSVG(100, 50, NewDefs(NewSymbol("icon", C(5, 5, 10)).SetViewBox(VB(0, 0, 10, 10))), U("#icon", 0, 0).SetWidth(Lth(20)).SetHeight(Lth(20))),
+			[]string{"gsave\nnewpath\n0 0 moveto\n20 0 lineto\n20 20 lineto\n0 20 lineto\nclosepath\nclip\nnewpath\ngsave\n[2 0 0 2 0 0] concat\n"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			if err := WriteEPS(&buf, tt.s); err != nil {
+				t.Fatalf("WriteEPS() error = %v", err)
+			}
+
+			for _, want := range tt.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("WriteEPS() = %s, want to contain %s", buf.String(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteEPS_noSize(t *testing.T) {
+	if err := WriteEPS(io.Discard, NewSVG(0, 0)); err == nil {
+		t.Errorf("WriteEPS() error = %v, wantErr %v", err, true)
+	}
+}
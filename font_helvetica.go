@@ -0,0 +1,46 @@
+package svg
+
+// HelveticaMetrics measures text set in Helvetica, one of the standard fonts every PDF and PostScript
+// viewer provides, so text laid out with it lines up with the text rendered by the viewer
+// Characters outside of printable ASCII get the width of an average glyph.
+type HelveticaMetrics struct {
+	FontSize float64
+}
+
+// NewHelveticaMetrics constructs new HelveticaMetrics
+func NewHelveticaMetrics(fontSize float64) HelveticaMetrics {
+	return HelveticaMetrics{FontSize: fontSize}
+}
+
+// helveticaWidths holds the widths of the printable ASCII characters starting with space in 1/1000 em
+// See: the Adobe Font Metrics file of Helvetica
+var helveticaWidths = [...]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278, // space - /
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556, // 0 - ?
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778, // @ - O
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556, // P - _
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556, // ` - o
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584, // p - ~
+}
+
+// helveticaAverageWidth is used for characters missing from helveticaWidths
+const helveticaAverageWidth = 556
+
+// Advance returns the width of s in user units
+func (hm HelveticaMetrics) Advance(s string) float64 {
+	w := 0
+	for _, r := range s {
+		if i := int(r) - ' '; i >= 0 && i < len(helveticaWidths) {
+			w += helveticaWidths[i]
+		} else {
+			w += helveticaAverageWidth
+		}
+	}
+
+	return float64(w) / 1000 * hm.FontSize
+}
+
+// Extent returns how far glyphs reach above and below the baseline in user units
+func (hm HelveticaMetrics) Extent() (float64, float64) {
+	return 0.718 * hm.FontSize, 0.207 * hm.FontSize
+}
@@ -0,0 +1,28 @@
+package svg
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHelveticaMetrics_Advance(t *testing.T) {
+	hm := NewHelveticaMetrics(10)
+
+	tests := []struct {
+		s    string
+		want float64
+	}{
+		{"", 0},
+		{" ", 2.78},
+		{"Wi", 11.66},
+		{"~", 5.84},
+		{"é", 5.56},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := hm.Advance(tt.s); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Advance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+//go:build ignore
+
+// gen_presentation generates presentation_setters.go, which holds the setters of the elements embedding
+// Presentation. Each of them wraps the setter of Presentation with the same name, so the elements can be
+// built fluently without a copy of every setter in every element.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+// elements holds the elements embedding Presentation together with the receiver names they use
+var elements = []struct {
+	Type, Recv string
+}{
+	{"Circle", "c"},
+	{"Ellipse", "el"},
+	{"Group", "g"},
+	{"Line", "l"},
+	{"Path", "p"},
+	{"Polygon", "pg"},
+	{"Polyline", "pl"},
+	{"Rect", "r"},
+	{"Symbol", "s"},
+	{"Text", "t"},
+	{"TSpan", "ts"},
+	{"Use", "u"},
+}
+
+// setter is a method of Presentation returning the modified Presentation
+type setter struct {
+	Name string
+	Doc  string
+	// Params is the parameter list of the method and Args the arguments passing them on
+	Params string
+	Args   string
+}
+
+func main() {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "presentation.go", nil, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var setters []setter
+	for _, decl := range f.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv == nil || !isPresentation(fd.Recv.List[0].Type) || !fd.Name.IsExported() {
+			continue
+		}
+		if fd.Type.Results == nil || len(fd.Type.Results.List) != 1 || !isPresentation(fd.Type.Results.List[0].Type) {
+			continue
+		}
+
+		s := setter{Name: fd.Name.Name, Doc: strings.TrimSpace(fd.Doc.Text())}
+
+		var params, args []string
+		for _, field := range fd.Type.Params.List {
+			var typ bytes.Buffer
+			if err := printer.Fprint(&typ, fset, field.Type); err != nil {
+				log.Fatal(err)
+			}
+
+			for _, name := range field.Names {
+				params = append(params, name.Name+" "+typ.String())
+				if _, variadic := field.Type.(*ast.Ellipsis); variadic {
+					args = append(args, name.Name+"...")
+				} else {
+					args = append(args, name.Name)
+				}
+			}
+		}
+		s.Params, s.Args = strings.Join(params, ", "), strings.Join(args, ", ")
+
+		setters = append(setters, s)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_presentation.go; DO NOT EDIT.\n\npackage svg\n")
+
+	for _, e := range elements {
+		fmt.Fprintf(&buf, "\n// SetPresentation sets all presentation attributes of a %s\n", e.Type)
+		fmt.Fprintf(&buf, "func (%s %s) SetPresentation(pr Presentation) %s {\n", e.Recv, e.Type, e.Type)
+		fmt.Fprintf(&buf, "\t%s.Presentation = pr\n\n\treturn %s\n}\n", e.Recv, e.Recv)
+
+		for _, s := range setters {
+			for _, line := range strings.Split(s.Doc, "\n") {
+				fmt.Fprintf(&buf, "\n// %s", strings.ReplaceAll(line, "a Presentation", "a "+e.Type))
+			}
+			fmt.Fprintf(&buf, "\nfunc (%s %s) %s(%s) %s {\n", e.Recv, e.Type, s.Name, s.Params, e.Type)
+			fmt.Fprintf(&buf, "\t%s.Presentation = %s.Presentation.%s(%s)\n\n\treturn %s\n}\n", e.Recv, e.Recv, s.Name, s.Args, e.Recv)
+		}
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("presentation_setters.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func isPresentation(expr ast.Expr) bool {
+	id, ok := expr.(*ast.Ident)
+
+	return ok && id.Name == "Presentation"
+}
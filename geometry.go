@@ -0,0 +1,700 @@
+package svg
+
+import (
+	"math"
+	"reflect"
+	"strings"
+)
+
+// shapeGeometry holds the outline of a shape together with the attributes affecting its extent
+// The outline is normalized, so it only consists of absolute M, L, C, Q and Z commands.
+type shapeGeometry struct {
+	Outline       PathData
+	Fill          *Paint
+	FillOpacity   *Opacity
+	Stroke        *Paint
+	StrokeWidth   *Length
+	StrokeOpacity *Opacity
+	Opacity       *Opacity
+}
+
+// hasStroke reports whether the outline of a shape is stroked
+func (sg shapeGeometry) hasStroke() bool {
+	return sg.Stroke != nil && sg.Stroke.Type != PaintNone
+}
+
+// strokeWidth returns the stroke width of a shape in user units, which defaults to 1
+func (sg shapeGeometry) strokeWidth(lr LengthResolver) float64 {
+	if sg.StrokeWidth == nil {
+		return 1
+	}
+
+	return lr.Resolve(*sg.StrokeWidth, AxisDiagonal)
+}
+
+// geometryOf returns the geometry of a shape, lengths are resolved with lr
+// Shapes which are not rendered, e.g. a Circle with a zero radius, have no geometry.
+func geometryOf(element interface{}, lr LengthResolver) (shapeGeometry, bool) {
+	var (
+		sg shapeGeometry
+		pd PathData
+	)
+
+	switch e := element.(type) {
+	case Circle:
+		r := lr.resolveExpr(e.R, AxisDiagonal)
+		if r <= 0 {
+			return sg, false
+		}
+
+		pd = ellipseOutline(lr.resolveExpr(e.CX, AxisX), lr.resolveExpr(e.CY, AxisY), r, r)
+	case Ellipse:
+		rx, ry := lr.resolveExpr(e.RX, AxisX), lr.resolveExpr(e.RY, AxisY)
+		// a missing radius is the same as the other one
+		if e.RX == nil {
+			rx = ry
+		}
+		if e.RY == nil {
+			ry = rx
+		}
+		if rx <= 0 || ry <= 0 {
+			return sg, false
+		}
+
+		pd = ellipseOutline(lr.resolveExpr(e.CX, AxisX), lr.resolveExpr(e.CY, AxisY), rx, ry)
+	case Rect:
+		w, h := lr.resolveExpr(e.Width, AxisX), lr.resolveExpr(e.Height, AxisY)
+		if w <= 0 || h <= 0 {
+			return sg, false
+		}
+
+		rx, ry := lr.resolveExpr(e.RX, AxisX), lr.resolveExpr(e.RY, AxisY)
+		if e.RX == nil {
+			rx = ry
+		}
+		if e.RY == nil {
+			ry = rx
+		}
+
+		pd = rectOutline(lr.resolveExpr(e.X, AxisX), lr.resolveExpr(e.Y, AxisY), w, h, rx, ry)
+	case Line:
+		pd = NewPathData().
+			MoveTo(lr.resolve(e.X1, AxisX), lr.resolve(e.Y1, AxisY)).
+			LineTo(lr.resolve(e.X2, AxisX), lr.resolve(e.Y2, AxisY))
+	case Path:
+		if e.D == nil {
+			return sg, false
+		}
+
+		pd = e.D.Normalize()
+	case Polygon:
+		if e.Points == nil || len(*e.Points) == 0 {
+			return sg, false
+		}
+
+		pd = pointsOutline(*e.Points).ClosePath()
+	case Polyline:
+		if e.Points == nil || len(*e.Points) == 0 {
+			return sg, false
+		}
+
+		pd = pointsOutline(*e.Points)
+	default:
+		return sg, false
+	}
+
+	sg = shapePaint(elementPresentation(element))
+	sg.Outline = pd
+
+	return sg, true
+}
+
+// shapePaint returns the painting attributes of a shape, which all shapes have in common
+func shapePaint(p Presentation) shapeGeometry {
+	return shapeGeometry{
+		Fill:          p.Fill,
+		FillOpacity:   p.FillOpacity,
+		Stroke:        p.Stroke,
+		StrokeWidth:   p.StrokeWidth,
+		StrokeOpacity: p.StrokeOpacity,
+		Opacity:       p.Opacity,
+	}
+}
+
+// ellipseOutline returns the outline of an ellipse as four cubic Bézier curves, starting at the right
+func ellipseOutline(cx, cy, rx, ry float64) PathData {
+	return NewPathData().
+		MoveTo(cx+rx, cy).
+		ArcTo(rx, ry, 0, false, true, cx, cy+ry).
+		ArcTo(rx, ry, 0, false, true, cx-rx, cy).
+		ArcTo(rx, ry, 0, false, true, cx, cy-ry).
+		ArcTo(rx, ry, 0, false, true, cx+rx, cy).
+		ClosePath().
+		Normalize()
+}
+
+// rectOutline returns the outline of a rectangle, rounded corners are clamped to half of its size
+// See: https://www.w3.org/TR/SVG2/shapes.html#RectElement
+func rectOutline(x, y, w, h, rx, ry float64) PathData {
+	rx = math.Max(0, math.Min(rx, w/2))
+	ry = math.Max(0, math.Min(ry, h/2))
+
+	if rx == 0 || ry == 0 {
+		return NewPathData().MoveTo(x, y).LineTo(x+w, y).LineTo(x+w, y+h).LineTo(x, y+h).ClosePath()
+	}
+
+	return NewPathData().
+		MoveTo(x+rx, y).
+		LineTo(x+w-rx, y).
+		ArcTo(rx, ry, 0, false, true, x+w, y+ry).
+		LineTo(x+w, y+h-ry).
+		ArcTo(rx, ry, 0, false, true, x+w-rx, y+h).
+		LineTo(x+rx, y+h).
+		ArcTo(rx, ry, 0, false, true, x, y+h-ry).
+		LineTo(x, y+ry).
+		ArcTo(rx, ry, 0, false, true, x+rx, y).
+		ClosePath().
+		Normalize()
+}
+
+func pointsOutline(ps Points) PathData {
+	pd := NewPathData().MoveTo(ps[0].X, ps[0].Y)
+	for _, p := range ps[1:] {
+		pd = pd.LineTo(p.X, p.Y)
+	}
+
+	return pd
+}
+
+// pathBBox returns the exact bounding box of a normalized PathData transformed by m
+// Transforming the control points first keeps the box tight under rotations and skews.
+// A moveto which is not followed by a segment does not extend the box.
+func pathBBox(pd PathData, m Matrix) BBox {
+	b := EmptyBBox()
+
+	var (
+		cx, cy float64
+		moved  bool
+	)
+
+	start := func() {
+		if moved {
+			b = b.AddPoint(cx, cy)
+			moved = false
+		}
+	}
+
+	for _, c := range pd.Commands {
+		p := c.Params
+
+		switch c.Type {
+		case MoveToAbs:
+			cx, cy = m.Apply(p[0], p[1])
+			moved = true
+		case LineToAbs:
+			start()
+			cx, cy = m.Apply(p[0], p[1])
+			b = b.AddPoint(cx, cy)
+		case CurveToAbs:
+			start()
+			x1, y1 := m.Apply(p[0], p[1])
+			x2, y2 := m.Apply(p[2], p[3])
+			x, y := m.Apply(p[4], p[5])
+			for _, t := range append(cubicExtrema(cx, x1, x2, x), cubicExtrema(cy, y1, y2, y)...) {
+				b = b.AddPoint(cubicAt(cx, x1, x2, x, t), cubicAt(cy, y1, y2, y, t))
+			}
+			cx, cy = x, y
+			b = b.AddPoint(cx, cy)
+		case QuadToAbs:
+			start()
+			x1, y1 := m.Apply(p[0], p[1])
+			x, y := m.Apply(p[2], p[3])
+			for _, t := range append(quadExtrema(cx, x1, x), quadExtrema(cy, y1, y)...) {
+				b = b.AddPoint(quadAt(cx, x1, x, t), quadAt(cy, y1, y, t))
+			}
+			cx, cy = x, y
+			b = b.AddPoint(cx, cy)
+		}
+	}
+
+	return b
+}
+
+// cubicExtrema returns the parameters between 0 and 1 where a cubic Bézier curve has a local extremum
+func cubicExtrema(p0, p1, p2, p3 float64) []float64 {
+	// the derivative is 3 * (a*t² + b*t + c)
+	d0, d1, d2 := p1-p0, p2-p1, p3-p2
+	a, b, c := d0-2*d1+d2, 2*(d1-d0), d0
+
+	var ts []float64
+
+	if math.Abs(a) < 1e-12 {
+		if b != 0 {
+			ts = append(ts, -c/b)
+		}
+	} else if disc := b*b - 4*a*c; disc >= 0 {
+		sq := math.Sqrt(disc)
+		ts = append(ts, (-b+sq)/(2*a), (-b-sq)/(2*a))
+	}
+
+	res := ts[:0]
+	for _, t := range ts {
+		if t > 0 && t < 1 {
+			res = append(res, t)
+		}
+	}
+
+	return res
+}
+
+func cubicAt(p0, p1, p2, p3, t float64) float64 {
+	mt := 1 - t
+
+	return mt*mt*mt*p0 + 3*mt*mt*t*p1 + 3*mt*t*t*p2 + t*t*t*p3
+}
+
+// quadExtrema returns the parameter between 0 and 1 where a quadratic Bézier curve has a local extremum
+func quadExtrema(p0, p1, p2 float64) []float64 {
+	d := p0 - 2*p1 + p2
+	if d == 0 {
+		return nil
+	}
+
+	if t := (p0 - p1) / d; t > 0 && t < 1 {
+		return []float64{t}
+	}
+
+	return nil
+}
+
+func quadAt(p0, p1, p2, t float64) float64 {
+	mt := 1 - t
+
+	return mt*mt*p0 + 2*mt*t*p1 + t*t*p2
+}
+
+// transformMatrix returns the Matrix of an optional Transform, invalid transforms are ignored like browsers do
+func transformMatrix(t *Transform) Matrix {
+	if t == nil {
+		return IdentityMatrix()
+	}
+
+	m, err := t.ToMatrix()
+	if err != nil {
+		return IdentityMatrix()
+	}
+
+	return m
+}
+
+// resolve returns an optional Length in user units, a missing Length is 0
+func (lr LengthResolver) resolve(l *Length, axis LengthAxis) float64 {
+	if l == nil {
+		return 0
+	}
+
+	return lr.Resolve(*l, axis)
+}
+
+// resolveExpr returns an optional LengthExpr in user units, a missing LengthExpr is 0
+func (lr LengthResolver) resolveExpr(e *LengthExpr, axis LengthAxis) float64 {
+	if e == nil {
+		return 0
+	}
+
+	return lr.ResolveExpr(*e, axis)
+}
+
+// geometryWalker visits the rendered shapes and texts of a tree of elements
+type geometryWalker struct {
+	lr LengthResolver
+	// fm measures Text elements, which are skipped without it
+	fm     FontMetrics
+	stroke bool
+	// ids holds the elements Use elements can refer to
+	ids map[string]interface{}
+	// following holds the ids of the Use references being followed, to stop at circular references
+	following map[string]bool
+	// inherited holds the presentation attributes the ancestors of the element being visited pass down
+	inherited Presentation
+	// enter and leave are called around the descendants of elements establishing a new user space,
+	// if set, enter gets the Matrix mapping the new user space into the current one
+	enter func(m Matrix)
+	leave func()
+	// clip is called with the viewports clipping the descendants of elements, if set, enter and leave
+	// have to be set as well, as the clip lasts until leave
+	clip func(pd PathData, rule FillRule)
+	// layer and composite are called around the descendants of containers with an opacity, if set,
+	// layer gets the opacity the descendants are composited with
+	layer     func(opacity float64)
+	composite func()
+}
+
+func newGeometryWalker(lr LengthResolver, stroke bool, roots ...interface{}) *geometryWalker {
+	gw := &geometryWalker{
+		lr:        lr,
+		stroke:    stroke,
+		ids:       map[string]interface{}{},
+		following: map[string]bool{},
+	}
+
+	gw.collectIDs(roots)
+
+	return gw
+}
+
+func (gw *geometryWalker) collectIDs(elements []interface{}) {
+	for _, e := range elements {
+		if id := elementID(e); id != "" {
+			if _, ok := gw.ids[id]; !ok {
+				gw.ids[id] = e
+			}
+		}
+
+		gw.collectIDs(elementChildren(e))
+	}
+}
+
+// ownTransform returns the Matrix of the transform attribute of an element
+func ownTransform(element interface{}) Matrix {
+	switch e := element.(type) {
+	case Group:
+		return transformMatrix(e.Transform)
+	case Circle:
+		return transformMatrix(e.Transform)
+	case Ellipse:
+		return transformMatrix(e.Transform)
+	case Rect:
+		return transformMatrix(e.Transform)
+	case Line:
+		return transformMatrix(e.Transform)
+	case Path:
+		return transformMatrix(e.Transform)
+	case Polygon:
+		return transformMatrix(e.Transform)
+	case Polyline:
+		return transformMatrix(e.Transform)
+	case Text:
+		return transformMatrix(e.Transform)
+	case Use:
+		return transformMatrix(e.Transform)
+	}
+
+	return IdentityMatrix()
+}
+
+// visit calls fn with every shape and Text of an element and the Matrix mapping it into the
+// coordinate system of m, until fn returns false
+// Elements which are not rendered directly, e.g. Defs and Symbol, are not visited.
+func (gw *geometryWalker) visit(element interface{}, m Matrix, fn func(leaf interface{}, m Matrix) bool) bool {
+	if !displayed(element) {
+		return true
+	}
+
+	m, leave := gw.push(m, ownTransform(element))
+	defer leave()
+
+	return gw.visitLocal(element, m, fn)
+}
+
+// visitLocal is the same as visit, but ignores the transform attribute of element itself
+func (gw *geometryWalker) visitLocal(element interface{}, m Matrix, fn func(leaf interface{}, m Matrix) bool) bool {
+	if !displayed(element) {
+		return true
+	}
+
+	switch e := element.(type) {
+	case Group:
+		defer gw.inherit(e.Presentation)()
+		leave, ok := gw.pushLayer(e.Opacity)
+		if !ok {
+			return true
+		}
+		defer leave()
+		return gw.visitAll(e.Children, m, fn)
+	case A:
+		return gw.visitAll(e.Children, m, fn)
+	case Use:
+		defer gw.inherit(e.Presentation)()
+		leave, ok := gw.pushLayer(e.Opacity)
+		if !ok {
+			return true
+		}
+		defer leave()
+		return gw.visitUse(e, m, fn)
+	case Text:
+		if gw.fm == nil {
+			return true
+		}
+		return fn(e, m)
+	case Circle, Ellipse, Rect, Line, Path, Polygon, Polyline:
+		return fn(e, m)
+	}
+
+	return true
+}
+
+func (gw *geometryWalker) visitAll(elements []interface{}, m Matrix, fn func(leaf interface{}, m Matrix) bool) bool {
+	for _, e := range elements {
+		if !gw.visit(e, m, fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// visitUse visits the element a Use refers to, placed at the position of the Use
+func (gw *geometryWalker) visitUse(u Use, m Matrix, fn func(leaf interface{}, m Matrix) bool) bool {
+	href := u.Href
+	if href == "" {
+		href = u.XLinkHref
+	}
+
+	id := strings.TrimPrefix(href, "#")
+
+	target, ok := gw.ids[id]
+	if !ok || gw.following[id] {
+		return true
+	}
+
+	gw.following[id] = true
+	defer delete(gw.following, id)
+
+	m, leave := gw.push(m, TranslateMatrix(gw.lr.resolveExpr(u.X, AxisX), gw.lr.resolveExpr(u.Y, AxisY)))
+	defer leave()
+
+	s, ok := target.(Symbol)
+	if !ok {
+		return gw.visit(target, m, fn)
+	}
+
+	defer gw.inherit(s.Presentation)()
+	leaveLayer, ok := gw.pushLayer(s.Opacity)
+	if !ok {
+		return true
+	}
+	defer leaveLayer()
+
+	if s.ViewBox != nil {
+		width, height := Lth(100, Percent).Expr(), Lth(100, Percent).Expr()
+		if u.Width != nil {
+			width = *u.Width
+		}
+		if u.Height != nil {
+			height = *u.Height
+		}
+
+		var par PreserveAspectRatio
+		if s.PreserveAspectRatio != nil {
+			par = *s.PreserveAspectRatio
+		}
+
+		w, h := gw.lr.ResolveExpr(width, AxisX), gw.lr.ResolveExpr(height, AxisY)
+
+		vm, err := s.ViewBox.ViewportMatrix(par, 0, 0, w, h)
+		if err != nil {
+			return true
+		}
+
+		// the content of a symbol overflowing its viewport is hidden
+		defer gw.pushClip(rectOutline(0, 0, w, h, 0, 0))()
+
+		var leaveViewBox func()
+		m, leaveViewBox = gw.push(m, vm)
+		defer leaveViewBox()
+	}
+
+	return gw.visitAll(s.Children, m, fn)
+}
+
+// push returns m multiplied by the Matrix n of a new user space, telling enter about it unless n is the identity
+// It returns a function to call when leaving the user space, which is meant to be deferred.
+func (gw *geometryWalker) push(m, n Matrix) (Matrix, func()) {
+	if gw.enter == nil || n == IdentityMatrix() {
+		return m.Multiply(n), func() {}
+	}
+
+	gw.enter(n)
+
+	return m.Multiply(n), gw.leave
+}
+
+// pushClip clips the descendants of the element being visited to the area of pd, if clip is set
+// It returns a function to call when leaving the element, which is meant to be deferred.
+func (gw *geometryWalker) pushClip(pd PathData) func() {
+	if gw.clip == nil {
+		return func() {}
+	}
+
+	gw.enter(IdentityMatrix())
+	gw.clip(pd, FillRuleNonZero)
+
+	return gw.leave
+}
+
+// pushLayer composites the descendants of the element being visited with its opacity, if layer is set
+// It reports false if the element is fully transparent, so its descendants are not to be visited, and
+// returns a function to call when leaving the element, which is meant to be deferred.
+func (gw *geometryWalker) pushLayer(o *Opacity) (func(), bool) {
+	if gw.layer == nil || o == nil {
+		return func() {}, true
+	}
+
+	switch opacity := o.Value(); opacity {
+	case 0:
+		return func() {}, false
+	case 1:
+		return func() {}, true
+	default:
+		gw.layer(opacity)
+	}
+
+	return gw.composite, true
+}
+
+// displayed reports whether an element is rendered, i.e. its display property is not none
+func displayed(element interface{}) bool {
+	d := elementPresentation(element).Display
+
+	return d == nil || *d != DisplayNone
+}
+
+// inherit passes the presentation attributes of an element down to its descendants being visited
+// It returns a function restoring the previous state, which is meant to be deferred.
+func (gw *geometryWalker) inherit(p Presentation) func() {
+	prev := gw.inherited
+	gw.inherited = inheritPresentation(prev, p)
+
+	return func() {
+		gw.inherited = prev
+	}
+}
+
+// geometry returns the geometry of a shape being visited, painted with the attributes it inherits as well
+func (gw *geometryWalker) geometry(leaf interface{}) (shapeGeometry, bool) {
+	sg, ok := geometryOf(leaf, gw.lr)
+	if !ok {
+		return sg, false
+	}
+
+	outline := sg.Outline
+	sg = shapePaint(gw.presentation(leaf))
+	sg.Outline = outline
+
+	return sg, true
+}
+
+// presentation returns the presentation attributes an element is rendered with, including the inherited ones
+func (gw *geometryWalker) presentation(element interface{}) Presentation {
+	return inheritPresentation(gw.inherited, elementPresentation(element))
+}
+
+// inheritPresentation returns the presentation attributes of child with the inherited properties it
+// does not set taken from parent
+// See: https://www.w3.org/TR/SVG11/propidx.html
+func inheritPresentation(parent, child Presentation) Presentation {
+	if child.StrokeWidth == nil {
+		child.StrokeWidth = parent.StrokeWidth
+	}
+	if child.Stroke == nil {
+		child.Stroke = parent.Stroke
+	}
+	if child.StrokeOpacity == nil {
+		child.StrokeOpacity = parent.StrokeOpacity
+	}
+	if child.Fill == nil {
+		child.Fill = parent.Fill
+	}
+	if child.FillOpacity == nil {
+		child.FillOpacity = parent.FillOpacity
+	}
+	if child.StrokeDashArray == nil {
+		child.StrokeDashArray = parent.StrokeDashArray
+	}
+	if child.StrokeDashOffset == nil {
+		child.StrokeDashOffset = parent.StrokeDashOffset
+	}
+	if child.StrokeLinecap == nil {
+		child.StrokeLinecap = parent.StrokeLinecap
+	}
+	if child.StrokeLinejoin == nil {
+		child.StrokeLinejoin = parent.StrokeLinejoin
+	}
+	if child.StrokeMiterlimit == nil {
+		child.StrokeMiterlimit = parent.StrokeMiterlimit
+	}
+	if child.FillRule == nil {
+		child.FillRule = parent.FillRule
+	}
+	if child.Visibility == nil {
+		child.Visibility = parent.Visibility
+	}
+
+	return child
+}
+
+// bbox returns the bounding box of an element, transformed by m
+func (gw *geometryWalker) bbox(element interface{}, m Matrix) BBox {
+	return gw.bboxLocal(element, m.Multiply(ownTransform(element)))
+}
+
+// bboxLocal returns the bounding box of an element, ignoring its own transform attribute
+func (gw *geometryWalker) bboxLocal(element interface{}, m Matrix) BBox {
+	b := EmptyBBox()
+
+	gw.visitLocal(element, m, func(leaf interface{}, m Matrix) bool {
+		b = b.Union(gw.leafBBox(leaf, m))
+
+		return true
+	})
+
+	return b
+}
+
+func (gw *geometryWalker) bboxAll(elements []interface{}, m Matrix) BBox {
+	b := EmptyBBox()
+	for _, e := range elements {
+		b = b.Union(gw.bbox(e, m))
+	}
+
+	return b
+}
+
+func (gw *geometryWalker) leafBBox(leaf interface{}, m Matrix) BBox {
+	if t, ok := leaf.(Text); ok {
+		return textBBox(layoutText(t, gw.lr, gw.fm), gw.fm, m)
+	}
+
+	sg, ok := gw.geometry(leaf)
+	if !ok {
+		return EmptyBBox()
+	}
+
+	b := pathBBox(sg.Outline, m)
+	if gw.stroke && sg.hasStroke() {
+		// the stroke is approximated by growing the box by half of the stroke width, which ignores
+		// miter joins, but is drawn in the local coordinate system, so it is scaled along with the shape
+		hw := sg.strokeWidth(gw.lr) / 2
+		b = b.Expand(hw*math.Hypot(m.A, m.C), hw*math.Hypot(m.B, m.D))
+	}
+
+	return b
+}
+
+// elementChildren returns the children of an element if it has any
+func elementChildren(element interface{}) []interface{} {
+	v := reflect.ValueOf(element)
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	f := v.FieldByName("Children")
+	if !f.IsValid() {
+		return nil
+	}
+
+	children, _ := f.Interface().([]interface{})
+
+	return children
+}
@@ -0,0 +1,359 @@
+package svg
+
+import (
+	"math"
+	"reflect"
+)
+
+// Geometry answers geometric questions about elements, e.g. their bounding boxes or whether they contain a point
+// Lengths are resolved with Lengths, Text is measured with Metrics and ignored if Metrics is nil.
+// All coordinates are in the user space of the element queried, i.e. its own transform attribute is
+// ignored, like getBBox() of browsers does, while the transforms of its descendants are applied.
+// Methods with a Stroke prefix include the stroke of shapes, others only their fill area.
+type Geometry struct {
+	Lengths LengthResolver
+	Metrics FontMetrics
+}
+
+// NewGeometry constructs new Geometry
+func NewGeometry(lr LengthResolver, fm FontMetrics) Geometry {
+	return Geometry{Lengths: lr, Metrics: fm}
+}
+
+// defaultGeometry is used by the BBox methods of elements, percentages resolve to 0 without a viewport
+var defaultGeometry = NewGeometry(NewLengthResolver(0, 0), nil)
+
+func (g Geometry) walker(stroke bool, element interface{}) *geometryWalker {
+	gw := newGeometryWalker(g.Lengths, stroke, element)
+	gw.fm = g.Metrics
+
+	return gw
+}
+
+// BBox returns the bounding box of the fill area of an element
+func (g Geometry) BBox(element interface{}) BBox {
+	return g.walker(false, element).bboxLocal(element, IdentityMatrix())
+}
+
+// StrokeBBox returns the bounding box of an element including the stroke of its shapes
+// The stroke is approximated by growing the box of each shape by half of its stroke width.
+func (g Geometry) StrokeBBox(element interface{}) BBox {
+	return g.walker(true, element).bboxLocal(element, IdentityMatrix())
+}
+
+// Contains reports whether the point (x, y) is inside the fill area of an element, using its fill-rule
+func (g Geometry) Contains(element interface{}, x, y float64) bool {
+	return g.hit(false, element, func(h leafHit) bool { return h.contains(x, y) })
+}
+
+// StrokeContains reports whether the point (x, y) is inside the fill area or the stroke of an element
+func (g Geometry) StrokeContains(element interface{}, x, y float64) bool {
+	return g.hit(true, element, func(h leafHit) bool { return h.contains(x, y) })
+}
+
+// Intersects reports whether the fill area of an element overlaps the rectangle b
+func (g Geometry) Intersects(element interface{}, b BBox) bool {
+	return g.hit(false, element, func(h leafHit) bool { return h.intersects(b) })
+}
+
+// StrokeIntersects reports whether the fill area or the stroke of an element overlaps the rectangle b
+// The stroke is approximated by growing b by half of the stroke width.
+func (g Geometry) StrokeIntersects(element interface{}, b BBox) bool {
+	return g.hit(true, element, func(h leafHit) bool { return h.intersects(b) })
+}
+
+// hit reports whether test is true for any of the shapes and texts of an element
+func (g Geometry) hit(stroke bool, element interface{}, test func(h leafHit) bool) bool {
+	gw := g.walker(stroke, element)
+
+	found := false
+	gw.visitLocal(element, IdentityMatrix(), func(leaf interface{}, m Matrix) bool {
+		h, ok := gw.leafHit(leaf, m)
+		found = ok && test(h)
+
+		return !found
+	})
+
+	return found
+}
+
+// leafHit is a shape or a text flattened into polygons in the coordinate system of the query
+type leafHit struct {
+	subpaths []subpath
+	evenOdd  bool
+	// halfStroke is the half of the stroke width in the coordinate system of the query, 0 if not stroked
+	halfStroke float64
+}
+
+func (gw *geometryWalker) leafHit(leaf interface{}, m Matrix) (leafHit, bool) {
+	if t, ok := leaf.(Text); ok {
+		// text is hit by its glyph boxes
+		runs := layoutText(t, gw.lr, gw.fm)
+		ascent, descent := gw.fm.Extent()
+
+		var h leafHit
+		for _, r := range runs {
+			pd := rectOutline(r.X, r.Y-ascent, r.Width, ascent+descent, 0, 0)
+			h.subpaths = append(h.subpaths, flattenPath(pd, m, flattenTolerance)...)
+		}
+
+		return h, len(h.subpaths) > 0
+	}
+
+	sg, ok := gw.geometry(leaf)
+	if !ok {
+		return leafHit{}, false
+	}
+
+	h := leafHit{subpaths: flattenPath(sg.Outline, m, flattenTolerance)}
+
+	if fr := gw.presentation(leaf).FillRule; fr != nil && *fr == FillRuleEvenOdd {
+		h.evenOdd = true
+	}
+
+	if gw.stroke && sg.hasStroke() {
+		h.halfStroke = sg.strokeWidth(gw.lr) / 2 * math.Sqrt(math.Abs(m.Determinant()))
+	}
+
+	return h, true
+}
+
+func (h leafHit) contains(x, y float64) bool {
+	if h.inFill(x, y) {
+		return true
+	}
+
+	if h.halfStroke <= 0 {
+		return false
+	}
+
+	for _, sp := range h.subpaths {
+		for _, s := range sp.segments(sp.Closed) {
+			if segmentDistance(x, y, s) <= h.halfStroke {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// inFill reports whether a point is inside the subpaths, which are implicitly closed, using the fill rule
+func (h leafHit) inFill(x, y float64) bool {
+	winding := 0
+
+	for _, sp := range h.subpaths {
+		for _, s := range sp.segments(true) {
+			switch {
+			case s[0].Y <= y && s[1].Y > y && cross(s, x, y) > 0:
+				winding++
+			case s[0].Y > y && s[1].Y <= y && cross(s, x, y) < 0:
+				winding--
+			}
+		}
+	}
+
+	if h.evenOdd {
+		return winding%2 != 0
+	}
+
+	return winding != 0
+}
+
+func (h leafHit) intersects(b BBox) bool {
+	if b.IsEmpty() {
+		return false
+	}
+
+	for _, sp := range h.subpaths {
+		for _, s := range sp.segments(true) {
+			if segmentIntersects(s, b) {
+				return true
+			}
+		}
+	}
+
+	// the rectangle can also be completely inside of the fill area
+	if h.inFill(b.MinX, b.MinY) {
+		return true
+	}
+
+	if h.halfStroke <= 0 {
+		return false
+	}
+
+	sb := b.Expand(h.halfStroke, h.halfStroke)
+	for _, sp := range h.subpaths {
+		for _, s := range sp.segments(sp.Closed) {
+			if segmentIntersects(s, sb) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// cross returns on which side of the segment s the point (x, y) is, positive means left
+func cross(s [2]Point, x, y float64) float64 {
+	return (s[1].X-s[0].X)*(y-s[0].Y) - (x-s[0].X)*(s[1].Y-s[0].Y)
+}
+
+// segmentDistance returns the distance of the point (x, y) from the segment s
+func segmentDistance(x, y float64, s [2]Point) float64 {
+	dx, dy := s[1].X-s[0].X, s[1].Y-s[0].Y
+
+	t := 0.0
+	if l := dx*dx + dy*dy; l > 0 {
+		t = math.Max(0, math.Min(1, ((x-s[0].X)*dx+(y-s[0].Y)*dy)/l))
+	}
+
+	return math.Hypot(x-(s[0].X+t*dx), y-(s[0].Y+t*dy))
+}
+
+// segmentIntersects reports whether the segment s touches the rectangle b, using Liang-Barsky clipping
+func segmentIntersects(s [2]Point, b BBox) bool {
+	dx, dy := s[1].X-s[0].X, s[1].Y-s[0].Y
+
+	t0, t1 := 0.0, 1.0
+	for _, e := range [4][2]float64{
+		{-dx, s[0].X - b.MinX},
+		{dx, b.MaxX - s[0].X},
+		{-dy, s[0].Y - b.MinY},
+		{dy, b.MaxY - s[0].Y},
+	} {
+		p, q := e[0], e[1]
+		if p == 0 {
+			if q < 0 {
+				return false
+			}
+			continue
+		}
+
+		r := q / p
+		if p < 0 {
+			t0 = math.Max(t0, r)
+		} else {
+			t1 = math.Min(t1, r)
+		}
+
+		if t0 > t1 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// flattenTolerance is the largest distance in user units allowed between a curve and its flattened form
+const flattenTolerance = 0.1
+
+// subpath is a subpath of an outline flattened into a polyline
+type subpath struct {
+	Points []Point
+	Closed bool
+}
+
+// segments returns the segments of a subpath, including the one closing it if closed is true
+func (sp subpath) segments(closed bool) [][2]Point {
+	if len(sp.Points) < 2 {
+		return nil
+	}
+
+	res := make([][2]Point, 0, len(sp.Points))
+	for i := 1; i < len(sp.Points); i++ {
+		res = append(res, [2]Point{sp.Points[i-1], sp.Points[i]})
+	}
+
+	if closed {
+		res = append(res, [2]Point{sp.Points[len(sp.Points)-1], sp.Points[0]})
+	}
+
+	return res
+}
+
+// flattenPath converts a normalized PathData transformed by m into polylines
+// Curves are split into as many lines as needed to stay within tolerance, see Wang's formula.
+func flattenPath(pd PathData, m Matrix, tolerance float64) []subpath {
+	var (
+		res  []subpath
+		cur  *subpath
+		last Point
+	)
+
+	point := func(x, y float64) Point {
+		px, py := m.Apply(x, y)
+
+		return Point{X: px, Y: py}
+	}
+
+	lineTo := func(p Point) {
+		if cur == nil {
+			res = append(res, subpath{Points: []Point{last}})
+			cur = &res[len(res)-1]
+		}
+
+		cur.Points = append(cur.Points, p)
+		last = p
+	}
+
+	for _, c := range pd.Commands {
+		p := c.Params
+
+		switch c.Type {
+		case MoveToAbs:
+			cur = nil
+			last = point(p[0], p[1])
+		case LineToAbs:
+			lineTo(point(p[0], p[1]))
+		case CurveToAbs:
+			p0, p1, p2, p3 := last, point(p[0], p[1]), point(p[2], p[3]), point(p[4], p[5])
+			dd := math.Max(
+				math.Hypot(p0.X-2*p1.X+p2.X, p0.Y-2*p1.Y+p2.Y),
+				math.Hypot(p1.X-2*p2.X+p3.X, p1.Y-2*p2.Y+p3.Y),
+			)
+			n := segmentCount(math.Sqrt(0.75 * dd / tolerance))
+			for i := 1; i <= n; i++ {
+				t := float64(i) / float64(n)
+				lineTo(Point{X: cubicAt(p0.X, p1.X, p2.X, p3.X, t), Y: cubicAt(p0.Y, p1.Y, p2.Y, p3.Y, t)})
+			}
+		case QuadToAbs:
+			p0, p1, p2 := last, point(p[0], p[1]), point(p[2], p[3])
+			dd := math.Hypot(p0.X-2*p1.X+p2.X, p0.Y-2*p1.Y+p2.Y)
+			n := segmentCount(math.Sqrt(0.25 * dd / tolerance))
+			for i := 1; i <= n; i++ {
+				t := float64(i) / float64(n)
+				lineTo(Point{X: quadAt(p0.X, p1.X, p2.X, t), Y: quadAt(p0.Y, p1.Y, p2.Y, t)})
+			}
+		case ClosePathAbs:
+			if cur != nil {
+				cur.Closed = true
+				last = cur.Points[0]
+				cur = nil
+			}
+		}
+	}
+
+	return res
+}
+
+func segmentCount(n float64) int {
+	return int(math.Max(1, math.Min(1000, math.Ceil(n))))
+}
+
+// elementPresentation returns the embedded Presentation of an element if it has one
+func elementPresentation(element interface{}) Presentation {
+	v := reflect.ValueOf(element)
+	if v.Kind() != reflect.Struct {
+		return Presentation{}
+	}
+
+	f := v.FieldByName("Presentation")
+	if !f.IsValid() {
+		return Presentation{}
+	}
+
+	p, _ := f.Interface().(Presentation)
+
+	return p
+}
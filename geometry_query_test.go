@@ -0,0 +1,143 @@
+package svg
+
+import (
+	"testing"
+)
+
+func TestShape_BBox(t *testing.T) {
+	red := Red.ToColor()
+
+	rounded := R(0, 0, 10, 6)
+	rounded.RX = exprOf(&Length{Number: 20})
+
+	tests := []struct {
+		name       string
+		bbox       BBox
+		strokeBBox BBox
+		want       BBox
+		wantStroke BBox
+	}{
+		{
+			"circle ignores its own transform",
+			C(10, 10, 5).SetTransform(NewTransform().Scale(3, 3)).BBox(),
+			C(10, 10, 5).SetStroke(red).SStrokeWidth(2).StrokeBBox(),
+			BBoxOf(5, 5, 10, 10),
+			BBoxOf(4, 4, 12, 12),
+		},
+		{
+			"ellipse",
+			El(0, 0, 4, 2).BBox(),
+			El(0, 0, 4, 2).StrokeBBox(),
+			BBoxOf(-4, -2, 8, 4),
+			BBoxOf(-4, -2, 8, 4),
+		},
+		{
+			"rounded rect with clamped radius",
+			rounded.BBox(),
+			rounded.SetStroke(red).StrokeBBox(),
+			BBoxOf(0, 0, 10, 6),
+			BBoxOf(-0.5, -0.5, 11, 7),
+		},
+		{
+			"line",
+			L(0, 10, 10, 0).BBox(),
+			L(0, 10, 10, 0).SetStroke(red).SStrokeWidth(4).StrokeBBox(),
+			BBoxOf(0, 0, 10, 10),
+			BBoxOf(-2, -2, 14, 14),
+		},
+		{
+			"polyline",
+			Pl(Pts(0, 0, 5, 5, 10, 0)).BBox(),
+			Pl(Pts(0, 0, 5, 5, 10, 0)).StrokeBBox(),
+			BBoxOf(0, 0, 10, 5),
+			BBoxOf(0, 0, 10, 5),
+		},
+		{
+			"group of transformed children",
+			NewGroup(C(0, 0, 1), R(0, 0, 1, 1).SetTransform(NewTransform().Translate(5, 5))).SetTransform(NewTransform().Scale(9, 9)).BBox(),
+			NewGroup(C(0, 0, 1).SetStroke(red)).StrokeBBox(),
+			BBoxOf(-1, -1, 7, 7),
+			BBoxOf(-1.5, -1.5, 3, 3),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !bboxAlmostEqual(tt.bbox, tt.want) {
+				t.Errorf("BBox() = %v, want %v", tt.bbox, tt.want)
+			}
+			if !bboxAlmostEqual(tt.strokeBBox, tt.wantStroke) {
+				t.Errorf("StrokeBBox() = %v, want %v", tt.strokeBBox, tt.wantStroke)
+			}
+		})
+	}
+}
+
+func TestGeometry_Contains(t *testing.T) {
+	red := Red.ToColor()
+	g := NewGeometry(NewLengthResolver(100, 100), NewFixedFontMetrics(10))
+
+	ring := P(NewPathData().MoveTo(0, 0).H(10).V(10).H(0).ClosePath().MoveTo(3, 3).V(7).H(7).V(3).ClosePath())
+	evenOdd := P(NewPathData().MoveTo(0, 0).H(10).V(10).H(0).ClosePath().MoveTo(3, 3).H(7).V(7).H(3).ClosePath()).
+		SetFillRule(FillRuleEvenOdd)
+
+	tests := []struct {
+		name       string
+		element    interface{}
+		x, y       float64
+		want       bool
+		wantStroke bool
+	}{
+		{"inside circle", C(10, 10, 5), 12, 12, true, true},
+		{"outside circle", C(10, 10, 5), 14, 14, false, false},
+		{"on stroke of circle", C(10, 10, 5).SetStroke(red).SStrokeWidth(2), 10, 15.5, false, true},
+		{"hole of opposite winding", ring, 5, 5, false, false},
+		{"ring of opposite winding", ring, 1, 5, true, true},
+		{"hole of even-odd rule", evenOdd, 5, 5, false, false},
+		{"line has no fill", L(0, 0, 10, 0).SetStroke(red), 5, 0, false, true},
+		{"transformed child", NewGroup(R(0, 0, 1, 1).SetTransform(NewTransform().Translate(5, 5))), 5.5, 5.5, true, true},
+		{"own transform is ignored", R(0, 0, 1, 1).SetTransform(NewTransform().Translate(5, 5)), 0.5, 0.5, true, true},
+		{"text", T(0, 10, TS("ab")), 11, 3, true, true},
+		{"next to text", T(0, 10, TS("ab")), 13, 3, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := g.Contains(tt.element, tt.x, tt.y); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+			if got := g.StrokeContains(tt.element, tt.x, tt.y); got != tt.wantStroke {
+				t.Errorf("StrokeContains() = %v, want %v", got, tt.wantStroke)
+			}
+		})
+	}
+}
+
+func TestGeometry_Intersects(t *testing.T) {
+	red := Red.ToColor()
+	g := NewGeometry(NewLengthResolver(100, 100), nil)
+
+	tests := []struct {
+		name       string
+		element    interface{}
+		b          BBox
+		want       bool
+		wantStroke bool
+	}{
+		{"rect crossing an edge", C(0, 0, 10), BBoxOf(8, -1, 5, 2), true, true},
+		{"rect inside", C(0, 0, 10), BBoxOf(-1, -1, 2, 2), true, true},
+		{"shape inside rect", C(0, 0, 1), BBoxOf(-5, -5, 10, 10), true, true},
+		{"corner of bbox outside circle", C(0, 0, 10), BBoxOf(8, 8, 2, 2), false, false},
+		{"reached by the stroke only", C(0, 0, 10).SetStroke(red).SStrokeWidth(4), BBoxOf(11, -1, 2, 2), false, true},
+		{"group", NewGroup(R(0, 0, 1, 1), R(20, 20, 1, 1)), BBoxOf(19, 19, 1.5, 1.5), true, true},
+		{"empty rect", C(0, 0, 10), EmptyBBox(), false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := g.Intersects(tt.element, tt.b); got != tt.want {
+				t.Errorf("Intersects() = %v, want %v", got, tt.want)
+			}
+			if got := g.StrokeIntersects(tt.element, tt.b); got != tt.wantStroke {
+				t.Errorf("StrokeIntersects() = %v, want %v", got, tt.wantStroke)
+			}
+		})
+	}
+}
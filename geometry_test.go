@@ -0,0 +1,80 @@
+package svg
+
+import (
+	"math"
+	"testing"
+)
+
+func bboxAlmostEqual(a, b BBox) bool {
+	const eps = 1e-3
+
+	return math.Abs(a.MinX-b.MinX) < eps && math.Abs(a.MinY-b.MinY) < eps &&
+		math.Abs(a.MaxX-b.MaxX) < eps && math.Abs(a.MaxY-b.MaxY) < eps
+}
+
+func TestGeometryWalker_bbox(t *testing.T) {
+	red := Red.ToColor()
+
+	tests := []struct {
+		name    string
+		element interface{}
+		stroke  bool
+		want    BBox
+	}{
+		{"circle", C(10, 20, 5), false, BBoxOf(5, 15, 10, 10)},
+		{"zero radius circle", C(10, 20, 0), false, EmptyBBox()},
+		{"ellipse", El(10, 20, 5, 3), false, BBoxOf(5, 17, 10, 6)},
+		{"rect", R(1, 2, 3, 4), false, BBoxOf(1, 2, 3, 4)},
+		{"line", L(5, 1, 1, 5), false, BBoxOf(1, 1, 4, 4)},
+		{"path with curve", P(NewPathData().MoveTo(0, 0).QuadTo(5, 10, 10, 0)), false, BBoxOf(0, 0, 10, 5)},
+		{"path with arc", P(NewPathData().MoveTo(0, 0).ArcTo(5, 5, 0, false, true, 10, 0)), false, BBoxOf(0, -5, 10, 5)},
+		{"lone moveto is ignored", P(NewPathData().MoveTo(-100, -100).MoveTo(0, 0).LineTo(1, 1)), false, BBoxOf(0, 0, 1, 1)},
+		{"polygon", Pg(Pts(0, 0, 10, 0, 5, -5)), false, BBoxOf(0, -5, 10, 5)},
+		{"stroke is ignored", C(10, 20, 5).SetStroke(red).SStrokeWidth(2), false, BBoxOf(5, 15, 10, 10)},
+		{"stroke", C(10, 20, 5).SetStroke(red).SStrokeWidth(2), true, BBoxOf(4, 14, 12, 12)},
+		{"default stroke width", L(0, 0, 10, 0).SetStroke(red), true, BBoxOf(-0.5, -0.5, 11, 1)},
+		{"stroke none", R(0, 0, 10, 10).SetStrokePaint(NonePaint()).SStrokeWidth(4), true, BBoxOf(0, 0, 10, 10)},
+		{"transformed stroke", C(0, 0, 1).SetStroke(red).SStrokeWidth(1).SetTransform(NewTransform().Scale(2, 3)), true, BBoxOf(-3, -4.5, 6, 9)},
+		{"rotated rect", R(0, 0, 10, 10).SetTransform(NewTransform().Rotate(45)), false, BBoxOf(-5*math.Sqrt2, 0, 10*math.Sqrt2, 10*math.Sqrt2)},
+		{"transformed path", P(NewPathData().MoveTo(0, 0).LineTo(1, 2)).SetTransform(NewTransform().Scale(2, 2)), false, BBoxOf(0, 0, 2, 4)},
+		{"transformed polygon", Pg(Pts(0, 0, 10, 0, 5, -5)).SetTransform(NewTransform().Translate(1, 1)), false, BBoxOf(1, -4, 10, 5)},
+		{"transformed polyline", Pl(Pts(0, 0, 10, 0)).SetTransform(NewTransform().Translate(0, 5)), false, BBoxOf(0, 5, 10, 0)},
+		{"rotated circle stays tight", C(0, 0, 5).SetTransform(NewTransform().Rotate(30)), false, BBoxOf(-5, -5, 10, 10)},
+		{
+			"nested groups and links",
+			NewGroup(
+				C(0, 0, 1),
+				NewGroup(NewA("#", R(0, 0, 2, 2))).SetTransform(NewTransform().Translate(10, 10)),
+			).SetTransform(NewTransform().Scale(2, 2)),
+			false,
+			BBoxOf(-2, -2, 26, 26),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw := newGeometryWalker(NewLengthResolver(100, 100), tt.stroke, tt.element)
+
+			got := gw.bbox(tt.element, IdentityMatrix())
+			if got.IsEmpty() != tt.want.IsEmpty() || (!got.IsEmpty() && !bboxAlmostEqual(got, tt.want)) {
+				t.Errorf("bbox() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeometryWalker_use(t *testing.T) {
+	s, dot := NewSVG(100, 100).Define("dot", C(0, 0, 1))
+	s.Children = append(s.Children, dot.At(10, 20), NewUse("#missing", nil, nil))
+
+	icon := NewSymbol("icon", R(0, 0, 10, 10)).SetViewBox(VB(0, 0, 10, 10))
+	icon2 := U("#icon", 50, 50)
+	icon2.Width, icon2.Height = exprOf(&Length{Number: 20}), exprOf(&Length{Number: 20})
+	loop := NewGroup(U("#loop", 1, 1)).SetID("loop")
+
+	s.Children = append(s.Children, NewDefs(icon, loop), icon2, U("#loop", 0, 0))
+
+	got := s.ContentBBox()
+	if want := BBoxOf(9, 19, 61, 51); !bboxAlmostEqual(got, want) {
+		t.Errorf("ContentBBox() = %v, want %v", got, want)
+	}
+}
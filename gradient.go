@@ -0,0 +1,497 @@
+package svg
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type GradientUnits string
+
+const (
+	UserSpaceOnUse    GradientUnits = "userSpaceOnUse"
+	ObjectBoundingBox GradientUnits = "objectBoundingBox"
+)
+
+func (gu *GradientUnits) UnmarshalText(text []byte) error {
+	v := GradientUnits(strings.TrimSpace(string(text)))
+
+	switch v {
+	default:
+		return fmt.Errorf("invalid gradientUnits: %s", string(text))
+	case UserSpaceOnUse,
+		ObjectBoundingBox:
+		*gu = v
+	}
+
+	return nil
+}
+
+func (gu GradientUnits) MarshalText() ([]byte, error) {
+	return []byte(gu), nil
+}
+
+type SpreadMethod string
+
+const (
+	SpreadPad     SpreadMethod = "pad"
+	SpreadReflect SpreadMethod = "reflect"
+	SpreadRepeat  SpreadMethod = "repeat"
+)
+
+func (sm *SpreadMethod) UnmarshalText(text []byte) error {
+	v := SpreadMethod(strings.TrimSpace(string(text)))
+
+	switch v {
+	default:
+		return fmt.Errorf("invalid spreadMethod: %s", string(text))
+	case SpreadPad,
+		SpreadReflect,
+		SpreadRepeat:
+		*sm = v
+	}
+
+	return nil
+}
+
+func (sm SpreadMethod) MarshalText() ([]byte, error) {
+	return []byte(sm), nil
+}
+
+// Stop represents a Stop SVG element, the color of a gradient at a given offset
+// See: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/stop
+type Stop struct {
+	XMLName     xml.Name
+	Offset      *Length         `xml:"offset,attr,omitempty"`
+	StopColor   *FormattedColor `xml:"stop-color,attr,omitempty"`
+	StopOpacity *Opacity        `xml:"stop-opacity,attr,omitempty"`
+	Attrs       []xml.Attr      `xml:",attr"`
+	lock        *sync.Mutex
+}
+
+// St constructs new Stop element, the offset is a number between 0 and 1 (shortcut)
+func St(offset float64, stopColor Color) Stop {
+	return NewStop(&Length{Number: offset}, &stopColor)
+}
+
+// NewStop constructs new Stop element, translucent colors are written as rgba()
+func NewStop(offset *Length, stopColor *Color) Stop {
+	s := Stop{
+		XMLName: xml.Name{Local: "stop"},
+		Offset:  offset,
+		lock:    &sync.Mutex{},
+	}
+
+	if stopColor != nil {
+		s.StopColor = &FormattedColor{Color: *stopColor, Format: alphaFormat(*stopColor, "")}
+	}
+
+	return s
+}
+
+// SetFormat sets the format the stop color of a Stop is written in, e.g. ColorHexAlpha to keep its alpha channel
+func (s Stop) SetFormat(f ColorFormat) Stop {
+	if s.StopColor != nil {
+		fc := *s.StopColor
+		fc.Format = f
+		s.StopColor = &fc
+	}
+
+	return s
+}
+
+// SetStopOpacity sets the stop opacity of a Stop
+func (s Stop) SetStopOpacity(so Opacity) Stop {
+	s.StopOpacity = &so
+
+	return s
+}
+
+// UnsetStopOpacity removes the previously set stop opacity of a Stop
+func (s Stop) UnsetStopOpacity() Stop {
+	s.StopOpacity = nil
+
+	return s
+}
+
+// AddAttr adds a new attribute of a Stop
+func (s Stop) AddAttr(name, value string) Stop {
+	s.lock.Lock()
+	s.Attrs = append(s.Attrs, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+	s.lock.Unlock()
+
+	return s
+}
+
+// RemoveAttr removes all attributes of a given name of a Stop
+func (s Stop) RemoveAttr(name string) Stop {
+	s.lock.Lock()
+	var attrs []xml.Attr
+	for _, attr := range s.Attrs {
+		if attr.Name.Local != name {
+			attrs = append(attrs, attr)
+		}
+	}
+	s.Attrs = attrs
+	s.lock.Unlock()
+
+	return s
+}
+
+// LinearGradient represents a LinearGradient SVG element
+// See: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/linearGradient
+type LinearGradient struct {
+	XMLName           xml.Name
+	ID                string         `xml:"id,attr,omitempty"`
+	X1                *Length        `xml:"x1,attr,omitempty"`
+	Y1                *Length        `xml:"y1,attr,omitempty"`
+	X2                *Length        `xml:"x2,attr,omitempty"`
+	Y2                *Length        `xml:"y2,attr,omitempty"`
+	GradientUnits     *GradientUnits `xml:"gradientUnits,attr,omitempty"`
+	GradientTransform *Transform     `xml:"gradientTransform,attr,omitempty"`
+	SpreadMethod      *SpreadMethod  `xml:"spreadMethod,attr,omitempty"`
+	Href              string         `xml:"href,attr,omitempty"`
+	Attrs             []xml.Attr     `xml:",attr"`
+	Children          []interface{}
+	lock              *sync.Mutex
+}
+
+// NewLinearGradient constructs new LinearGradient element
+func NewLinearGradient(id string, children ...interface{}) LinearGradient {
+	lg := LinearGradient{
+		XMLName: xml.Name{Local: "linearGradient"},
+		ID:      id,
+		lock:    &sync.Mutex{},
+	}
+
+	lg.Children = append(lg.Children, children...)
+
+	return lg
+}
+
+// Paint returns a Paint referencing a LinearGradient
+func (lg LinearGradient) Paint() Paint {
+	return URLPaint(lg.ID)
+}
+
+// SetVector sets the start and end point of the gradient vector of a LinearGradient
+func (lg LinearGradient) SetVector(x1, y1, x2, y2 Length) LinearGradient {
+	lg.X1, lg.Y1, lg.X2, lg.Y2 = &x1, &y1, &x2, &y2
+
+	return lg
+}
+
+// UnsetVector removes the previously set gradient vector of a LinearGradient
+func (lg LinearGradient) UnsetVector() LinearGradient {
+	lg.X1, lg.Y1, lg.X2, lg.Y2 = nil, nil, nil, nil
+
+	return lg
+}
+
+// SetGradientUnits sets the coordinate system of a LinearGradient
+func (lg LinearGradient) SetGradientUnits(gu GradientUnits) LinearGradient {
+	lg.GradientUnits = &gu
+
+	return lg
+}
+
+// SetGradientTransform sets the gradient transform of a LinearGradient
+func (lg LinearGradient) SetGradientTransform(transform Transform) LinearGradient {
+	lg.GradientTransform = &transform
+
+	return lg
+}
+
+// UnsetGradientTransform removes the previously set gradient transform of a LinearGradient
+func (lg LinearGradient) UnsetGradientTransform() LinearGradient {
+	lg.GradientTransform = nil
+
+	return lg
+}
+
+// SetSpreadMethod sets the spread method of a LinearGradient
+func (lg LinearGradient) SetSpreadMethod(sm SpreadMethod) LinearGradient {
+	lg.SpreadMethod = &sm
+
+	return lg
+}
+
+// SetHref sets the reference to another gradient a LinearGradient inherits its stops and attributes from
+func (lg LinearGradient) SetHref(id string) LinearGradient {
+	lg.Href = "#" + id
+
+	return lg
+}
+
+// Inherit returns a LinearGradient with all unset attributes and missing stops taken from ref
+// ref is the gradient referenced by Href, either a LinearGradient or a RadialGradient. The
+// x1, y1, x2 and y2 attributes are only inherited from another LinearGradient.
+func (lg LinearGradient) Inherit(ref interface{}) LinearGradient {
+	var base gradientBase
+
+	switch r := ref.(type) {
+	case LinearGradient:
+		if lg.X1 == nil {
+			lg.X1 = r.X1
+		}
+		if lg.Y1 == nil {
+			lg.Y1 = r.Y1
+		}
+		if lg.X2 == nil {
+			lg.X2 = r.X2
+		}
+		if lg.Y2 == nil {
+			lg.Y2 = r.Y2
+		}
+		base = gradientBase{r.GradientUnits, r.GradientTransform, r.SpreadMethod, r.Children}
+	case RadialGradient:
+		base = gradientBase{r.GradientUnits, r.GradientTransform, r.SpreadMethod, r.Children}
+	default:
+		return lg
+	}
+
+	if lg.GradientUnits == nil {
+		lg.GradientUnits = base.units
+	}
+	if lg.GradientTransform == nil {
+		lg.GradientTransform = base.transform
+	}
+	if lg.SpreadMethod == nil {
+		lg.SpreadMethod = base.spread
+	}
+	if !hasStops(lg.Children) {
+		lg.Children = inheritStops(lg.Children, base.children)
+	}
+
+	return lg
+}
+
+// AddAttr adds a new attribute of a LinearGradient
+func (lg LinearGradient) AddAttr(name, value string) LinearGradient {
+	lg.lock.Lock()
+	lg.Attrs = append(lg.Attrs, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+	lg.lock.Unlock()
+
+	return lg
+}
+
+// RemoveAttr removes all attributes of a given name of a LinearGradient
+func (lg LinearGradient) RemoveAttr(name string) LinearGradient {
+	lg.lock.Lock()
+	var attrs []xml.Attr
+	for _, attr := range lg.Attrs {
+		if attr.Name.Local != name {
+			attrs = append(attrs, attr)
+		}
+	}
+	lg.Attrs = attrs
+	lg.lock.Unlock()
+
+	return lg
+}
+
+// RadialGradient represents a RadialGradient SVG element
+// See: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/radialGradient
+type RadialGradient struct {
+	XMLName           xml.Name
+	ID                string         `xml:"id,attr,omitempty"`
+	CX                *Length        `xml:"cx,attr,omitempty"`
+	CY                *Length        `xml:"cy,attr,omitempty"`
+	R                 *Length        `xml:"r,attr,omitempty"`
+	FX                *Length        `xml:"fx,attr,omitempty"`
+	FY                *Length        `xml:"fy,attr,omitempty"`
+	FR                *Length        `xml:"fr,attr,omitempty"`
+	GradientUnits     *GradientUnits `xml:"gradientUnits,attr,omitempty"`
+	GradientTransform *Transform     `xml:"gradientTransform,attr,omitempty"`
+	SpreadMethod      *SpreadMethod  `xml:"spreadMethod,attr,omitempty"`
+	Href              string         `xml:"href,attr,omitempty"`
+	Attrs             []xml.Attr     `xml:",attr"`
+	Children          []interface{}
+	lock              *sync.Mutex
+}
+
+// NewRadialGradient constructs new RadialGradient element
+func NewRadialGradient(id string, children ...interface{}) RadialGradient {
+	rg := RadialGradient{
+		XMLName: xml.Name{Local: "radialGradient"},
+		ID:      id,
+		lock:    &sync.Mutex{},
+	}
+
+	rg.Children = append(rg.Children, children...)
+
+	return rg
+}
+
+// Paint returns a Paint referencing a RadialGradient
+func (rg RadialGradient) Paint() Paint {
+	return URLPaint(rg.ID)
+}
+
+// SetCircle sets the center and the radius of the end circle of a RadialGradient
+func (rg RadialGradient) SetCircle(cx, cy, r Length) RadialGradient {
+	rg.CX, rg.CY, rg.R = &cx, &cy, &r
+
+	return rg
+}
+
+// UnsetCircle removes the previously set end circle of a RadialGradient
+func (rg RadialGradient) UnsetCircle() RadialGradient {
+	rg.CX, rg.CY, rg.R = nil, nil, nil
+
+	return rg
+}
+
+// SetFocus sets the center and the radius of the start circle of a RadialGradient
+func (rg RadialGradient) SetFocus(fx, fy, fr Length) RadialGradient {
+	rg.FX, rg.FY, rg.FR = &fx, &fy, &fr
+
+	return rg
+}
+
+// UnsetFocus removes the previously set start circle of a RadialGradient
+func (rg RadialGradient) UnsetFocus() RadialGradient {
+	rg.FX, rg.FY, rg.FR = nil, nil, nil
+
+	return rg
+}
+
+// SetGradientUnits sets the coordinate system of a RadialGradient
+func (rg RadialGradient) SetGradientUnits(gu GradientUnits) RadialGradient {
+	rg.GradientUnits = &gu
+
+	return rg
+}
+
+// SetGradientTransform sets the gradient transform of a RadialGradient
+func (rg RadialGradient) SetGradientTransform(transform Transform) RadialGradient {
+	rg.GradientTransform = &transform
+
+	return rg
+}
+
+// UnsetGradientTransform removes the previously set gradient transform of a RadialGradient
+func (rg RadialGradient) UnsetGradientTransform() RadialGradient {
+	rg.GradientTransform = nil
+
+	return rg
+}
+
+// SetSpreadMethod sets the spread method of a RadialGradient
+func (rg RadialGradient) SetSpreadMethod(sm SpreadMethod) RadialGradient {
+	rg.SpreadMethod = &sm
+
+	return rg
+}
+
+// SetHref sets the reference to another gradient a RadialGradient inherits its stops and attributes from
+func (rg RadialGradient) SetHref(id string) RadialGradient {
+	rg.Href = "#" + id
+
+	return rg
+}
+
+// Inherit returns a RadialGradient with all unset attributes and missing stops taken from ref
+// ref is the gradient referenced by Href, either a LinearGradient or a RadialGradient. The
+// cx, cy, r, fx, fy and fr attributes are only inherited from another RadialGradient.
+func (rg RadialGradient) Inherit(ref interface{}) RadialGradient {
+	var base gradientBase
+
+	switch r := ref.(type) {
+	case RadialGradient:
+		if rg.CX == nil {
+			rg.CX = r.CX
+		}
+		if rg.CY == nil {
+			rg.CY = r.CY
+		}
+		if rg.R == nil {
+			rg.R = r.R
+		}
+		if rg.FX == nil {
+			rg.FX = r.FX
+		}
+		if rg.FY == nil {
+			rg.FY = r.FY
+		}
+		if rg.FR == nil {
+			rg.FR = r.FR
+		}
+		base = gradientBase{r.GradientUnits, r.GradientTransform, r.SpreadMethod, r.Children}
+	case LinearGradient:
+		base = gradientBase{r.GradientUnits, r.GradientTransform, r.SpreadMethod, r.Children}
+	default:
+		return rg
+	}
+
+	if rg.GradientUnits == nil {
+		rg.GradientUnits = base.units
+	}
+	if rg.GradientTransform == nil {
+		rg.GradientTransform = base.transform
+	}
+	if rg.SpreadMethod == nil {
+		rg.SpreadMethod = base.spread
+	}
+	if !hasStops(rg.Children) {
+		rg.Children = inheritStops(rg.Children, base.children)
+	}
+
+	return rg
+}
+
+// AddAttr adds a new attribute of a RadialGradient
+func (rg RadialGradient) AddAttr(name, value string) RadialGradient {
+	rg.lock.Lock()
+	rg.Attrs = append(rg.Attrs, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+	rg.lock.Unlock()
+
+	return rg
+}
+
+// RemoveAttr removes all attributes of a given name of a RadialGradient
+func (rg RadialGradient) RemoveAttr(name string) RadialGradient {
+	rg.lock.Lock()
+	var attrs []xml.Attr
+	for _, attr := range rg.Attrs {
+		if attr.Name.Local != name {
+			attrs = append(attrs, attr)
+		}
+	}
+	rg.Attrs = attrs
+	rg.lock.Unlock()
+
+	return rg
+}
+
+// gradientBase holds the attributes shared by linear and radial gradients
+type gradientBase struct {
+	units     *GradientUnits
+	transform *Transform
+	spread    *SpreadMethod
+	children  []interface{}
+}
+
+func hasStops(children []interface{}) bool {
+	for _, child := range children {
+		if _, ok := child.(Stop); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// inheritStops appends the stops found in from to a copy of children
+func inheritStops(children, from []interface{}) []interface{} {
+	res := append([]interface{}(nil), children...)
+
+	for _, child := range from {
+		if s, ok := child.(Stop); ok {
+			res = append(res, s)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,183 @@
+package svg
+
+import (
+	"encoding/xml"
+	"image/color"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLinearGradient_MarshalText(t *testing.T) {
+	red := Color{color.RGBA{R: 255, A: 255}}
+	navy := Color{color.RGBA{B: 128, A: 255}}
+
+	tests := []struct {
+		name    string
+		lg      LinearGradient
+		want    string
+		wantErr bool
+	}{
+		{
+			"simple gradient",
+			NewLinearGradient("grad", St(0, red), St(1, navy)),
+			`<linearGradient id="grad"><stop offset="0" stop-color="#ff0000"></stop><stop offset="1" stop-color="#000080"></stop></linearGradient>`,
+			false,
+		},
+		{
+			"gradient with all attributes",
+			NewLinearGradient("grad", NewStop(&Length{50, Percent}, &red).SetStopOpacity(O(0.5))).
+				SetVector(Lth(0), Lth(0), Lth(100, Percent), Lth(0)).
+				SetGradientUnits(UserSpaceOnUse).
+				SetGradientTransform(NewTransform().Rotate(90)).
+				SetSpreadMethod(SpreadReflect).
+				SetHref("base"),
+			`<linearGradient id="grad" x1="0" y1="0" x2="100%" y2="0" gradientUnits="userSpaceOnUse" gradientTransform="rotate(90)" spreadMethod="reflect" href="#base">` +
+				`<stop offset="50%" stop-color="#ff0000" stop-opacity="0.5"></stop></linearGradient>`,
+			false,
+		},
+		{
+			"gradient with unset attributes",
+			NewLinearGradient("grad").
+				SetVector(Lth(0), Lth(0), Lth(1), Lth(1)).UnsetVector().
+				SetGradientTransform(NewTransform().Rotate(90)).UnsetGradientTransform().
+				AddAttr("foo", "Foo").AddAttr("bar", "Bar").RemoveAttr("foo"),
+			`<linearGradient id="grad" bar="Bar"></linearGradient>`,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBytes, err := xml.Marshal(tt.lg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("xml.Marshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got := string(gotBytes); got != tt.want {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRadialGradient_MarshalText(t *testing.T) {
+	red := Color{color.RGBA{R: 255, A: 255}}
+
+	tests := []struct {
+		name    string
+		rg      RadialGradient
+		want    string
+		wantErr bool
+	}{
+		{
+			"simple gradient",
+			NewRadialGradient("grad", St(0.5, red)),
+			`<radialGradient id="grad"><stop offset="0.5" stop-color="#ff0000"></stop></radialGradient>`,
+			false,
+		},
+		{
+			"gradient with all attributes",
+			NewRadialGradient("grad").
+				SetCircle(Lth(50, Percent), Lth(50, Percent), Lth(50, Percent)).
+				SetFocus(Lth(25, Percent), Lth(25, Percent), Lth(0)).
+				SetGradientUnits(ObjectBoundingBox).
+				SetSpreadMethod(SpreadRepeat).
+				SetHref("base"),
+			`<radialGradient id="grad" cx="50%" cy="50%" r="50%" fx="25%" fy="25%" fr="0" gradientUnits="objectBoundingBox" spreadMethod="repeat" href="#base"></radialGradient>`,
+			false,
+		},
+		{
+			"gradient with unset attributes",
+			NewRadialGradient("grad").
+				SetCircle(Lth(1), Lth(1), Lth(1)).UnsetCircle().
+				SetFocus(Lth(1), Lth(1), Lth(1)).UnsetFocus().
+				AddAttr("foo", "Foo").AddAttr("bar", "Bar").RemoveAttr("foo"),
+			`<radialGradient id="grad" bar="Bar"></radialGradient>`,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBytes, err := xml.Marshal(tt.rg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("xml.Marshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got := string(gotBytes); got != tt.want {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinearGradient_Inherit(t *testing.T) {
+	red := Color{color.RGBA{R: 255, A: 255}}
+	navy := Color{color.RGBA{B: 128, A: 255}}
+
+	base := NewLinearGradient("base", St(0, red), St(1, navy)).
+		SetVector(Lth(0), Lth(0), Lth(1), Lth(0)).
+		SetSpreadMethod(SpreadReflect)
+
+	got := NewLinearGradient("child").SetHref("base").SetSpreadMethod(SpreadPad).Inherit(base)
+
+	if !reflect.DeepEqual(got.Children, base.Children) {
+		t.Errorf("Inherit() stops = %v, want %v", got.Children, base.Children)
+	}
+	if got.X2 == nil || *got.X2 != Lth(1) {
+		t.Errorf("Inherit() x2 = %v, want 1", got.X2)
+	}
+	if got.SpreadMethod == nil || *got.SpreadMethod != SpreadPad {
+		t.Errorf("Inherit() spreadMethod = %v, want pad", got.SpreadMethod)
+	}
+
+	own := NewLinearGradient("own", St(0.5, navy)).Inherit(base)
+	if len(own.Children) != 1 {
+		t.Errorf("Inherit() should keep own stops, got %v", own.Children)
+	}
+
+	fromRadial := NewLinearGradient("child").Inherit(NewRadialGradient("r", St(0, red)).SetCircle(Lth(1), Lth(1), Lth(1)).SetGradientUnits(UserSpaceOnUse))
+	if len(fromRadial.Children) != 1 || fromRadial.GradientUnits == nil || *fromRadial.GradientUnits != UserSpaceOnUse {
+		t.Errorf("Inherit() from radial = %v", fromRadial)
+	}
+}
+
+func TestRadialGradient_Inherit(t *testing.T) {
+	red := Color{color.RGBA{R: 255, A: 255}}
+
+	base := NewRadialGradient("base", St(0, red)).SetCircle(Lth(1), Lth(2), Lth(3))
+
+	got := NewRadialGradient("child").Inherit(base)
+	if got.CY == nil || *got.CY != Lth(2) || len(got.Children) != 1 {
+		t.Errorf("Inherit() = %v", got)
+	}
+
+	fromLinear := NewRadialGradient("child").Inherit(NewLinearGradient("l").SetVector(Lth(0), Lth(0), Lth(1), Lth(1)))
+	if fromLinear.CX != nil {
+		t.Errorf("Inherit() should not take geometry from a linear gradient, got %v", fromLinear.CX)
+	}
+}
+
+func TestLinearGradient_UnmarshalXML(t *testing.T) {
+	input := `<linearGradient id="grad" x2="100%" gradientUnits="userSpaceOnUse" spreadMethod="bogus">` +
+		`<stop offset="0.5" stop-color="red" stop-opacity="0.3"/></linearGradient>`
+
+	var lg LinearGradient
+	if err := xml.NewDecoder(strings.NewReader(input)).Decode(&lg); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	if lg.ID != "grad" || lg.X2 == nil || *lg.X2 != Lth(100, Percent) || lg.GradientUnits == nil || *lg.GradientUnits != UserSpaceOnUse {
+		t.Errorf("Decode() = %v", lg)
+	}
+	if lg.SpreadMethod != nil || len(lg.Attrs) != 1 {
+		t.Errorf("Decode() invalid spreadMethod should be kept in attrs, got %v %v", lg.SpreadMethod, lg.Attrs)
+	}
+	if len(lg.Children) != 1 {
+		t.Fatalf("Decode() children = %v", lg.Children)
+	}
+
+	s, ok := lg.Children[0].(Stop)
+	if !ok || s.Offset == nil || *s.Offset != Lth(0.5) || s.StopColor == nil || s.StopColor.R != 255 || s.StopOpacity == nil {
+		t.Errorf("Decode() stop = %#v", lg.Children[0])
+	}
+}
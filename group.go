@@ -8,7 +8,9 @@ import (
 // Group represents a G SVG element
 // See: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/g
 type Group struct {
-	XMLName  xml.Name
+	XMLName   xml.Name
+	Transform *Transform `xml:"transform,attr,omitempty"`
+	Presentation
 	Attrs    []xml.Attr `xml:",attr"`
 	Children []interface{}
 	lock     *sync.Mutex
@@ -26,6 +28,20 @@ func NewGroup(children ...interface{}) Group {
 	return g
 }
 
+// SetTransform sets the transform of a Group
+func (g Group) SetTransform(transform Transform) Group {
+	g.Transform = &transform
+
+	return g
+}
+
+// UnsetTransform removes the previously set transform of a Group
+func (g Group) UnsetTransform() Group {
+	g.Transform = nil
+
+	return g
+}
+
 // AddAttr adds a new attribute of a Group
 func (g Group) AddAttr(name, value string) Group {
 	g.lock.Lock()
@@ -49,3 +65,14 @@ func (g Group) RemoveAttr(name string) Group {
 
 	return g
 }
+
+// BBox returns the bounding box of the fill area of a Group, without its transform
+// Percentages are resolved against an empty viewport, use Geometry for other viewports.
+func (g Group) BBox() BBox {
+	return defaultGeometry.BBox(g)
+}
+
+// StrokeBBox returns the bounding box of a Group including its stroke, without its transform
+func (g Group) StrokeBBox() BBox {
+	return defaultGeometry.StrokeBBox(g)
+}
@@ -45,6 +45,18 @@ func TestGroup_MarshalText(t *testing.T) {
 			[]string{`<g></g>`},
 			false,
 		},
+		{
+			"group with transform",
+			NewGroup(C(1, 2, 3)).SetTransform(NewTransform().Translate(10, 20).Rotate(45)),
+			[]string{`<g transform="translate(10 20) rotate(45)"><circle cx="1" cy="2" r="3"></circle></g>`},
+			false,
+		},
+		{
+			"group with transform removed",
+			NewGroup().SetTransform(NewTransform().Translate(10, 20)).UnsetTransform(),
+			[]string{`<g></g>`},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
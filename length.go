@@ -21,27 +21,11 @@ const (
 )
 
 func (lt LengthType) String() string {
-	t := strings.ToLower(string(lt))
+	t := LengthType(strings.ToLower(string(lt)))
 
 	switch t {
-	case string(Em):
-		return string(Em)
-	case string(Ex):
-		return string(Em)
-	case string(Px):
-		return string(Em)
-	case string(In):
-		return string(Em)
-	case string(Cm):
-		return string(Em)
-	case string(Mm):
-		return string(Em)
-	case string(Pt):
-		return string(Em)
-	case string(Pc):
-		return string(Em)
-	case string(Percent):
-		return string(Percent)
+	case Em, Ex, Px, In, Cm, Mm, Pt, Pc, Percent:
+		return string(t)
 	}
 
 	return ""
@@ -0,0 +1,476 @@
+package svg
+
+import (
+	"strings"
+)
+
+// LengthFunc is the function of a LengthExpr
+type LengthFunc string
+
+const (
+	LengthSum LengthFunc = "calc"
+	LengthMin LengthFunc = "min"
+	LengthMax LengthFunc = "max"
+)
+
+// LengthExpr is a length which can also be a symbolic expression of lengths, which can not be folded into
+// a single unit without knowing the viewport, e.g. 50% - 10px
+// It is a plain Length if Func is empty, otherwise the sum, minimum or maximum of Args.
+// Expressions are kept normalized: sums are flattened, like units are folded and scaling is
+// pushed down to the arguments, so the arguments of a sum are never sums themselves.
+// Use LengthResolver.ResolveExpr to collapse an expression once the viewport is known.
+// The geometry properties of shapes, e.g. the width of a Rect or the r of a Circle, are LengthExprs.
+// See: https://www.w3.org/TR/css-values-4/#calc-notation
+type LengthExpr struct {
+	Func   LengthFunc
+	Length Length
+	Args   []LengthExpr
+}
+
+// Expr returns a Length as a LengthExpr, e.g. to add an expression to it
+func (l Length) Expr() LengthExpr {
+	return LengthExpr{Length: l}
+}
+
+// exprOf returns an optional Length as an optional LengthExpr, a missing Length stays missing
+func exprOf(l *Length) *LengthExpr {
+	if l == nil {
+		return nil
+	}
+
+	e := l.Expr()
+
+	return &e
+}
+
+// Add returns the sum of two lengths
+// Lengths of the same unit are folded, otherwise the result is a calc() expression.
+func (l Length) Add(other Length) LengthExpr {
+	return l.Expr().Add(other.Expr())
+}
+
+// Sub returns the difference of two lengths
+// Lengths of the same unit are folded, otherwise the result is a calc() expression.
+func (l Length) Sub(other Length) LengthExpr {
+	return l.Expr().Sub(other.Expr())
+}
+
+// Scale returns a Length multiplied by f
+func (l Length) Scale(f float64) Length {
+	return Length{Number: l.Number * f, Type: l.Type}
+}
+
+// Min returns the smallest of the lengths
+// Lengths of the same unit are compared, otherwise the result is a min() expression.
+func (l Length) Min(others ...Length) LengthExpr {
+	return l.Expr().Min(exprsOf(others)...)
+}
+
+// Max returns the largest of the lengths
+// Lengths of the same unit are compared, otherwise the result is a max() expression.
+func (l Length) Max(others ...Length) LengthExpr {
+	return l.Expr().Max(exprsOf(others)...)
+}
+
+func exprsOf(lengths []Length) []LengthExpr {
+	res := make([]LengthExpr, len(lengths))
+	for i, l := range lengths {
+		res[i] = l.Expr()
+	}
+
+	return res
+}
+
+// IsLength reports whether a LengthExpr is a plain Length
+func (e LengthExpr) IsLength() bool {
+	return e.Func == ""
+}
+
+// Add returns the sum of two length expressions
+func (e LengthExpr) Add(other LengthExpr) LengthExpr {
+	return sumOf(append(e.sumTerms(), other.sumTerms()...))
+}
+
+// Sub returns the difference of two length expressions
+func (e LengthExpr) Sub(other LengthExpr) LengthExpr {
+	return e.Add(other.Scale(-1))
+}
+
+// Scale returns a length expression multiplied by f
+func (e LengthExpr) Scale(f float64) LengthExpr {
+	if e.IsLength() {
+		return e.Length.Scale(f).Expr()
+	}
+
+	args := make([]LengthExpr, len(e.Args))
+	for i, a := range e.Args {
+		args[i] = a.Scale(f)
+	}
+
+	switch e.Func {
+	case LengthMin:
+		if f < 0 {
+			return extremeOf(LengthMax, args)
+		}
+		return extremeOf(LengthMin, args)
+	case LengthMax:
+		if f < 0 {
+			return extremeOf(LengthMin, args)
+		}
+		return extremeOf(LengthMax, args)
+	}
+
+	return sumOf(args)
+}
+
+// Min returns the smallest of the length expressions
+func (e LengthExpr) Min(others ...LengthExpr) LengthExpr {
+	return extremeOf(LengthMin, append([]LengthExpr{e}, others...))
+}
+
+// Max returns the largest of the length expressions
+func (e LengthExpr) Max(others ...LengthExpr) LengthExpr {
+	return extremeOf(LengthMax, append([]LengthExpr{e}, others...))
+}
+
+// String returns a plain length as it is, e.g. "10px", and an expression wrapped in calc(), e.g. "calc(50% - 10px)"
+func (e LengthExpr) String() string {
+	if e.IsLength() {
+		return e.Length.String()
+	}
+
+	return "calc(" + e.term() + ")"
+}
+
+// term returns a length expression as it appears inside of a calc() expression
+func (e LengthExpr) term() string {
+	if e.IsLength() {
+		return e.Length.String()
+	}
+
+	var sb strings.Builder
+
+	if e.Func != LengthSum {
+		sb.WriteString(string(e.Func))
+		sb.WriteString("(")
+		for i, a := range e.Args {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(a.term())
+		}
+		sb.WriteString(")")
+
+		return sb.String()
+	}
+
+	for i, a := range e.Args {
+		switch {
+		case i == 0:
+			sb.WriteString(a.term())
+		case a.IsLength() && a.Length.Number < 0:
+			sb.WriteString(" - ")
+			sb.WriteString(a.Scale(-1).term())
+		default:
+			sb.WriteString(" + ")
+			sb.WriteString(a.term())
+		}
+	}
+
+	return sb.String()
+}
+
+func (e LengthExpr) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText parses a length, which can also be a calc(), min() or max() expression
+func (e *LengthExpr) UnmarshalText(text []byte) error {
+	newExpr, err := ParseLengthExpr(string(text))
+	if err != nil {
+		return err
+	}
+
+	*e = newExpr
+
+	return nil
+}
+
+// sumTerms returns the terms of a length expression as a sum
+func (e LengthExpr) sumTerms() []LengthExpr {
+	if e.Func == LengthSum {
+		return e.Args
+	}
+
+	return []LengthExpr{e}
+}
+
+// sumOf folds terms of the same unit and drops zero terms
+func sumOf(terms []LengthExpr) LengthExpr {
+	var args []LengthExpr
+
+	for _, t := range terms {
+		if t.Func == LengthSum {
+			args = append(args, t.Args...)
+			continue
+		}
+
+		if i := findUnit(args, t); i >= 0 {
+			args[i].Length.Number += t.Length.Number
+			continue
+		}
+
+		args = append(args, t)
+	}
+
+	res := args[:0]
+	for _, a := range args {
+		if a.IsLength() && a.Length.Number == 0 {
+			continue
+		}
+		res = append(res, a)
+	}
+
+	switch len(res) {
+	case 0:
+		if len(terms) > 0 && terms[0].IsLength() {
+			return Length{Type: terms[0].Length.Type}.Expr()
+		}
+		return LengthExpr{}
+	case 1:
+		return res[0]
+	}
+
+	return LengthExpr{Func: LengthSum, Args: res}
+}
+
+// extremeOf returns the smallest or the largest of the length expressions, comparing lengths of the same unit
+func extremeOf(fn LengthFunc, exprs []LengthExpr) LengthExpr {
+	var args []LengthExpr
+
+	for _, e := range exprs {
+		if e.Func == fn {
+			args = append(args, e.Args...)
+			continue
+		}
+
+		i := findUnit(args, e)
+		switch {
+		case i < 0:
+			args = append(args, e)
+		case fn == LengthMin && e.Length.Number < args[i].Length.Number, fn == LengthMax && e.Length.Number > args[i].Length.Number:
+			args[i].Length.Number = e.Length.Number
+		}
+	}
+
+	if len(args) == 1 {
+		return args[0]
+	}
+
+	return LengthExpr{Func: fn, Args: args}
+}
+
+// findUnit returns the index of the plain length in exprs which has the same unit as e
+func findUnit(exprs []LengthExpr, e LengthExpr) int {
+	if !e.IsLength() {
+		return -1
+	}
+
+	for i, o := range exprs {
+		if o.IsLength() && unitKey(o.Length.Type) == unitKey(e.Length.Type) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// unitKey returns the normalized form of a unit, user units and px are the same
+func unitKey(lt LengthType) LengthType {
+	t := LengthType(lt.String())
+	if t == Px {
+		return ""
+	}
+
+	return t
+}
+
+// ParseLengthExpr parses a length, which can also be a calc(), min() or max() expression
+// Expressions are folded as much as possible, so "calc(1px + 2px)" results in 3px.
+func ParseLengthExpr(s string) (LengthExpr, error) {
+	t := strings.ToLower(strings.TrimSpace(s))
+
+	if !strings.HasSuffix(t, ")") {
+		var l Length
+		if err := l.UnmarshalText([]byte(t)); err != nil {
+			return LengthExpr{}, err
+		}
+
+		return l.Expr(), nil
+	}
+
+	p := lengthParser{pathDataParser{s: t}}
+
+	e, err := p.sum()
+	if err == nil {
+		p.skipWsp()
+		if !p.eof() {
+			err = p.errorf("unexpected %q", p.s[p.pos])
+		}
+	}
+	if err != nil {
+		return LengthExpr{}, renameParseError("length", err)
+	}
+
+	return e, nil
+}
+
+// lengthParser reuses the number parsing of path data
+type lengthParser struct {
+	pathDataParser
+}
+
+func (p *lengthParser) sum() (LengthExpr, error) {
+	e, err := p.product()
+	if err != nil {
+		return e, err
+	}
+
+	for {
+		p.skipWsp()
+		if p.eof() || (p.s[p.pos] != '+' && p.s[p.pos] != '-') {
+			return e, nil
+		}
+
+		op := p.s[p.pos]
+		p.pos++
+
+		r, err := p.product()
+		if err != nil {
+			return e, err
+		}
+
+		if op == '+' {
+			e = e.Add(r)
+		} else {
+			e = e.Sub(r)
+		}
+	}
+}
+
+func (p *lengthParser) product() (LengthExpr, error) {
+	e, err := p.factor()
+	if err != nil {
+		return e, err
+	}
+
+	for {
+		p.skipWsp()
+		if p.eof() || (p.s[p.pos] != '*' && p.s[p.pos] != '/') {
+			return e, nil
+		}
+
+		op := p.s[p.pos]
+		start := p.pos
+		p.pos++
+
+		r, err := p.factor()
+		if err != nil {
+			return e, err
+		}
+
+		switch {
+		case op == '/' && (!isNumber(r) || r.Length.Number == 0):
+			p.pos = start
+			return e, p.errorf("division by a length or zero")
+		case op == '/':
+			e = e.Scale(1 / r.Length.Number)
+		case isNumber(e):
+			e = r.Scale(e.Length.Number)
+		case isNumber(r):
+			e = e.Scale(r.Length.Number)
+		default:
+			p.pos = start
+			return e, p.errorf("multiplication of two lengths")
+		}
+	}
+}
+
+// isNumber reports whether a length expression is a number without a unit
+func isNumber(e LengthExpr) bool {
+	return e.IsLength() && e.Length.Type == ""
+}
+
+func (p *lengthParser) factor() (LengthExpr, error) {
+	p.skipWsp()
+
+	rest := p.s[p.pos:]
+
+	switch {
+	case strings.HasPrefix(rest, "("):
+		p.pos++
+		return p.group(LengthSum)
+	case strings.HasPrefix(rest, "calc("):
+		p.pos += len("calc(")
+		return p.group(LengthSum)
+	case strings.HasPrefix(rest, "min("):
+		p.pos += len("min(")
+		return p.group(LengthMin)
+	case strings.HasPrefix(rest, "max("):
+		p.pos += len("max(")
+		return p.group(LengthMax)
+	}
+
+	n, err := p.number()
+	if err != nil {
+		return LengthExpr{}, err
+	}
+
+	start := p.pos
+	for !p.eof() && (p.s[p.pos] == '%' || (p.s[p.pos] >= 'a' && p.s[p.pos] <= 'z')) {
+		p.pos++
+	}
+
+	unit := p.s[start:p.pos]
+	if lt := LengthType(unit); lt.String() != unit {
+		p.pos = start
+		return LengthExpr{}, p.errorf("unknown unit %q", unit)
+	}
+
+	return Length{Number: n, Type: LengthType(unit)}.Expr(), nil
+}
+
+// group parses the comma separated arguments of a function up to the closing parenthesis
+func (p *lengthParser) group(fn LengthFunc) (LengthExpr, error) {
+	var args []LengthExpr
+
+	for {
+		e, err := p.sum()
+		if err != nil {
+			return e, err
+		}
+		args = append(args, e)
+
+		p.skipWsp()
+		if p.eof() {
+			return LengthExpr{}, p.errorf("unexpected end of length, expected %q", ')')
+		}
+
+		c := p.s[p.pos]
+		p.pos++
+
+		switch {
+		case c == ')':
+			if fn == LengthSum {
+				return args[0], nil
+			}
+			return extremeOf(fn, args), nil
+		case c == ',' && fn != LengthSum:
+			continue
+		}
+
+		p.pos--
+
+		return LengthExpr{}, p.errorf("unexpected %q", c)
+	}
+}
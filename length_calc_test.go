@@ -0,0 +1,155 @@
+package svg
+
+import (
+	"encoding/xml"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestLength_Arithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		got  LengthExpr
+		want string
+	}{
+		{"add same unit", Lth(5, Px).Add(Lth(10, Px)), "15px"},
+		{"add user units and px", Lth(5).Add(Lth(10, Px)), "15"},
+		{"add case insensitive unit", Lth(1, "EM").Add(Lth(2, Em)), "3em"},
+		{"add mixed units", Lth(50, Percent).Add(Lth(10, Px)), "calc(50% + 10px)"},
+		{"sub mixed units", Lth(50, Percent).Sub(Lth(10, Px)), "calc(50% - 10px)"},
+		{"sub to zero", Lth(50, Percent).Sub(Lth(50, Percent)), "0%"},
+		{"fold into expression", Lth(50, Percent).Sub(Lth(10, Px)).Add(Lth(4, Px).Expr()), "calc(50% - 6px)"},
+		{"collapse expression", Lth(50, Percent).Sub(Lth(10, Px)).Sub(Lth(50, Percent).Expr()), "-10px"},
+		{"scale", Lth(3, Em).Scale(2).Expr(), "6em"},
+		{"scale expression", Lth(50, Percent).Sub(Lth(10, Px)).Scale(2), "calc(100% - 20px)"},
+		{"min same unit", Lth(5, Px).Min(Lth(3, Px), Lth(4, Px)), "3px"},
+		{"max same unit", Lth(5, Px).Max(Lth(3, Px), Lth(7)), "7px"},
+		{"min mixed units", Lth(50, Percent).Min(Lth(100, Px)), "calc(min(50%, 100px))"},
+		{"min folds like units", Lth(50, Percent).Min(Lth(100, Px), Lth(20, Percent)), "calc(min(20%, 100px))"},
+		{"negated min is max", Lth(50, Percent).Min(Lth(100, Px)).Scale(-1), "calc(max(-50%, -100px))"},
+		{"min in sum", Lth(50, Percent).Min(Lth(100, Px)).Add(Lth(1, Em).Expr()), "calc(min(50%, 100px) + 1em)"},
+		{"sum in max", Lth(50, Percent).Sub(Lth(10, Px)).Max(Lth(2, Em).Expr()), "calc(max(50% - 10px, 2em))"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLengthExpr(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{"plain", "10px", "10px", false},
+		{"calc", "calc(50% - 10px)", "calc(50% - 10px)", false},
+		{"calc folded", "calc(10px + 5px)", "15px", false},
+		{"upper case", "CALC(50% + 1EM)", "calc(50% + 1em)", false},
+		{"nested", "calc(100% - (2 * 10px + 1em) / 2)", "calc(100% - 10px - 0.5em)", false},
+		{"negative number", "calc(50% - -10px)", "calc(50% + 10px)", false},
+		{"exponent", "calc(1e1px + 1em)", "calc(10px + 1em)", false},
+		{"min", "min(50%, 100px)", "calc(min(50%, 100px))", false},
+		{"max in calc", "calc(max(50%, 10px) + 1em)", "calc(max(50%, 10px) + 1em)", false},
+		{"unknown unit", "calc(1foo + 1px)", "", true},
+		{"unclosed", "calc((1px + 1em)", "", true},
+		{"multiplication of lengths", "calc(1px * 1em)", "", true},
+		{"division by zero", "calc(1px / 0)", "", true},
+		{"comma in calc", "calc(1px, 1em)", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLengthExpr(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLengthExpr() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseLengthExpr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLengthExpr_roundTrip(t *testing.T) {
+	w := Lth(100, Percent).Sub(Lth(20, Px)).Min(Lth(40, Em).Expr())
+
+	text, err := w.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v", err)
+	}
+	if string(text) != "calc(min(100% - 20px, 40em))" {
+		t.Errorf("MarshalText() = %s, want calc(min(100%% - 20px, 40em))", text)
+	}
+
+	var got LengthExpr
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if got.String() != string(text) {
+		t.Errorf("UnmarshalText() got = %v, want %s", got, text)
+	}
+
+	lr := NewLengthResolver(300, 200)
+	if v := lr.ResolveExpr(got, AxisX); math.Abs(v-280) > 1e-9 {
+		t.Errorf("ResolveExpr() = %v, want 280", v)
+	}
+	if c := lr.ConvertExpr(got, Pt, AxisX); math.Abs(c.Number-210) > 1e-9 || c.Type != Pt {
+		t.Errorf("ConvertExpr() = %v, want 210pt", c)
+	}
+
+	lr.Width = 1000
+	if v := lr.ResolveExpr(got, AxisX); v != 640 {
+		t.Errorf("ResolveExpr() = %v, want 640", v)
+	}
+}
+
+func TestLengthExpr_elements(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		element interface{}
+		want    BBox
+	}{
+		{
+			"rect",
+			`<rect x="calc(10% - 5px)" width="calc(100% - 20px)" height="10"></rect>`,
+			&Rect{},
+			BBoxOf(5, 0, 80, 10),
+		},
+		{
+			"circle",
+			`<circle cx="50%" cy="50%" r="calc(min(20px, 10%))"></circle>`,
+			&Circle{},
+			BBoxOf(40, 40, 20, 20),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := xml.Unmarshal([]byte(tt.text), tt.element); err != nil {
+				t.Fatalf("xml.Unmarshal() error = %v", err)
+			}
+
+			el := reflect.ValueOf(tt.element).Elem()
+			if attrs := el.FieldByName("Attrs").Len(); attrs != 0 {
+				t.Errorf("xml.Unmarshal() kept %d attributes untyped", attrs)
+			}
+
+			got, err := xml.Marshal(el.Interface())
+			if err != nil {
+				t.Fatalf("xml.Marshal() error = %v", err)
+			}
+			if string(got) != tt.text {
+				t.Errorf("xml.Marshal() got = %s, want %v", got, tt.text)
+			}
+
+			if b := NewGeometry(NewLengthResolver(100, 100), nil).BBox(el.Interface()); b != tt.want {
+				t.Errorf("BBox() = %v, want %v", b, tt.want)
+			}
+		})
+	}
+}
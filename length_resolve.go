@@ -0,0 +1,146 @@
+package svg
+
+import (
+	"math"
+	"strings"
+)
+
+// LengthAxis defines which dimension of the viewport a percentage length refers to
+// See: https://www.w3.org/TR/SVG11/coords.html#Units
+type LengthAxis int
+
+const (
+	// AxisX is used for horizontal lengths, e.g. x, cx, width and rx
+	AxisX LengthAxis = iota
+	// AxisY is used for vertical lengths, e.g. y, cy, height and ry
+	AxisY
+	// AxisDiagonal is used for all other lengths, e.g. r and stroke-width, percentages refer to
+	// the normalized diagonal of the viewport: sqrt(width² + height²) / sqrt(2)
+	AxisDiagonal
+)
+
+// AxisOf returns the axis percentages of an attribute refer to
+func AxisOf(attr string) LengthAxis {
+	switch attr {
+	case "x", "cx", "x1", "x2", "dx", "fx", "width", "rx", "refX", "markerWidth":
+		return AxisX
+	case "y", "cy", "y1", "y2", "dy", "fy", "height", "ry", "refY", "markerHeight":
+		return AxisY
+	}
+
+	return AxisDiagonal
+}
+
+// Default values of LengthResolver
+const (
+	DefaultDPI      = 96.0
+	DefaultFontSize = 16.0
+)
+
+// LengthResolver converts lengths to user units (px)
+// Absolute units are converted using DPI, font relative units using FontSize and XHeight, and
+// percentages using the size of the viewport they are relative to.
+type LengthResolver struct {
+	DPI      float64
+	FontSize float64
+	XHeight  float64
+	Width    float64
+	Height   float64
+}
+
+// NewLengthResolver constructs a LengthResolver for a viewport of the given size in user units,
+// using the CSS reference of 96 DPI and a 16px font size with an x-height of half the font size.
+func NewLengthResolver(width, height float64) LengthResolver {
+	return LengthResolver{
+		DPI:      DefaultDPI,
+		FontSize: DefaultFontSize,
+		XHeight:  DefaultFontSize / 2,
+		Width:    width,
+		Height:   height,
+	}
+}
+
+// Resolve returns a Length in user units, axis defines the reference dimension of percentages
+func (lr LengthResolver) Resolve(l Length, axis LengthAxis) float64 {
+	return l.Number * lr.unitSize(l.Type, axis)
+}
+
+// ResolveExpr returns a LengthExpr in user units, so a calc() expression collapses to a plain number
+func (lr LengthResolver) ResolveExpr(e LengthExpr, axis LengthAxis) float64 {
+	if e.Func == "" {
+		return lr.Resolve(e.Length, axis)
+	}
+
+	if len(e.Args) == 0 {
+		return 0
+	}
+
+	res := lr.ResolveExpr(e.Args[0], axis)
+	for _, a := range e.Args[1:] {
+		v := lr.ResolveExpr(a, axis)
+
+		switch e.Func {
+		case LengthMin:
+			res = math.Min(res, v)
+		case LengthMax:
+			res = math.Max(res, v)
+		default:
+			res += v
+		}
+	}
+
+	return res
+}
+
+// Convert returns a Length converted to the given unit
+func (lr LengthResolver) Convert(l Length, to LengthType, axis LengthAxis) Length {
+	size := lr.unitSize(to, axis)
+	if size == 0 {
+		return Length{Type: to}
+	}
+
+	return Length{Number: lr.Resolve(l, axis) / size, Type: to}
+}
+
+// ConvertExpr returns a LengthExpr resolved and converted to the given unit
+func (lr LengthResolver) ConvertExpr(e LengthExpr, to LengthType, axis LengthAxis) Length {
+	return lr.Convert(Length{Number: lr.ResolveExpr(e, axis)}, to, axis)
+}
+
+// unitSize returns the size of a single unit in user units
+func (lr LengthResolver) unitSize(lt LengthType, axis LengthAxis) float64 {
+	switch LengthType(strings.ToLower(string(lt))) {
+	case Px:
+		return 1
+	case In:
+		return lr.DPI
+	case Cm:
+		return lr.DPI / 2.54
+	case Mm:
+		return lr.DPI / 25.4
+	case Pt:
+		return lr.DPI / 72
+	case Pc:
+		return lr.DPI / 6
+	case Em:
+		return lr.FontSize
+	case Ex:
+		return lr.XHeight
+	case Percent:
+		return lr.reference(axis) / 100
+	}
+
+	return 1
+}
+
+// reference returns the dimension of the viewport percentages on the given axis refer to
+func (lr LengthResolver) reference(axis LengthAxis) float64 {
+	switch axis {
+	case AxisX:
+		return lr.Width
+	case AxisY:
+		return lr.Height
+	}
+
+	return math.Hypot(lr.Width, lr.Height) / math.Sqrt2
+}
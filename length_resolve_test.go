@@ -0,0 +1,103 @@
+package svg
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLengthResolver_Resolve(t *testing.T) {
+	lr := NewLengthResolver(300, 400)
+
+	tests := []struct {
+		name string
+		l    Length
+		axis LengthAxis
+		want float64
+	}{
+		{"user units", Lth(12), AxisX, 12},
+		{"px", Lth(12, Px), AxisY, 12},
+		{"in", Lth(1, In), AxisX, 96},
+		{"cm", Lth(2.54, Cm), AxisX, 96},
+		{"mm", Lth(25.4, Mm), AxisX, 96},
+		{"pt", Lth(72, Pt), AxisX, 96},
+		{"pc", Lth(6, Pc), AxisX, 96},
+		{"em", Lth(2, Em), AxisX, 32},
+		{"ex", Lth(2, Ex), AxisX, 16},
+		{"upper case unit", Lth(1, "IN"), AxisX, 96},
+		{"percent of width", Lth(50, Percent), AxisX, 150},
+		{"percent of height", Lth(50, Percent), AxisY, 200},
+		{"percent of diagonal", Lth(100, Percent), AxisDiagonal, 500 / math.Sqrt2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lr.Resolve(tt.l, tt.axis); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Resolve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLengthResolver_Custom(t *testing.T) {
+	lr := LengthResolver{DPI: 300, FontSize: 10, XHeight: 4, Width: 100, Height: 100}
+
+	if got := lr.Resolve(Lth(1, In), AxisX); got != 300 {
+		t.Errorf("Resolve() in = %v, want 300", got)
+	}
+	if got := lr.Resolve(Lth(1, Em), AxisX); got != 10 {
+		t.Errorf("Resolve() em = %v, want 10", got)
+	}
+	if got := lr.Resolve(Lth(1, Ex), AxisX); got != 4 {
+		t.Errorf("Resolve() ex = %v, want 4", got)
+	}
+	if got := lr.Resolve(Lth(10, Percent), AxisDiagonal); math.Abs(got-10) > 1e-9 {
+		t.Errorf("Resolve() diagonal percent of a square = %v, want 10", got)
+	}
+}
+
+func TestLengthResolver_Convert(t *testing.T) {
+	lr := NewLengthResolver(200, 100)
+
+	tests := []struct {
+		name string
+		l    Length
+		to   LengthType
+		axis LengthAxis
+		want Length
+	}{
+		{"in to mm", Lth(1, In), Mm, AxisX, Lth(25.4, Mm)},
+		{"px to percent", Lth(50, Px), Percent, AxisY, Lth(50, Percent)},
+		{"percent to px", Lth(50, Percent), Px, AxisX, Lth(100, Px)},
+		{"to percent of empty viewport", Lth(50, Px), Percent, AxisX, Length{Type: Percent}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.name == "to percent of empty viewport" {
+				lr = NewLengthResolver(0, 0)
+			}
+			got := lr.Convert(tt.l, tt.to, tt.axis)
+			if got.Type != tt.want.Type || math.Abs(got.Number-tt.want.Number) > 1e-9 {
+				t.Errorf("Convert() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAxisOf(t *testing.T) {
+	tests := map[string]LengthAxis{
+		"x":            AxisX,
+		"cx":           AxisX,
+		"width":        AxisX,
+		"rx":           AxisX,
+		"y":            AxisY,
+		"y2":           AxisY,
+		"height":       AxisY,
+		"ry":           AxisY,
+		"r":            AxisDiagonal,
+		"stroke-width": AxisDiagonal,
+	}
+	for attr, want := range tests {
+		if got := AxisOf(attr); got != want {
+			t.Errorf("AxisOf(%q) = %v, want %v", attr, got, want)
+		}
+	}
+}
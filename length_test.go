@@ -210,3 +210,27 @@ func TestLength_UnmarshalText(t *testing.T) {
 		})
 	}
 }
+
+func TestLength_RoundTrip(t *testing.T) {
+	for _, lt := range []LengthType{"", Em, Ex, Px, In, Cm, Mm, Pt, Pc, Percent} {
+		t.Run(string(lt), func(t *testing.T) {
+			l := Lth(12.5, lt)
+
+			text, err := l.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() error = %v", err)
+			}
+			if want := "12.5" + string(lt); string(text) != want {
+				t.Errorf("MarshalText() got = %s, want %s", text, want)
+			}
+
+			var got Length
+			if err := got.UnmarshalText(text); err != nil {
+				t.Fatalf("UnmarshalText() error = %v", err)
+			}
+			if got != l {
+				t.Errorf("UnmarshalText() got = %v, want %v", got, l)
+			}
+		})
+	}
+}
@@ -8,20 +8,16 @@ import (
 // Line represents a Line SVG element
 // See: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/line
 type Line struct {
-	XMLName       xml.Name
-	X1            *Length    `xml:"x1,attr,omitempty"`
-	Y1            *Length    `xml:"y1,attr,omitempty"`
-	X2            *Length    `xml:"x2,attr,omitempty"`
-	Y2            *Length    `xml:"y2,attr,omitempty"`
-	StrokeWidth   *uint8     `xml:"stroke-width,attr,omitempty"`
-	Stroke        *Color     `xml:"stroke,attr,omitempty"`
-	StrokeOpacity *Opacity   `xml:"stroke-opacity,attr,omitempty"`
-	Fill          *Color     `xml:"fill,attr,omitempty"`
-	FillOpacity   *Opacity   `xml:"fill-opacity,attr,omitempty"`
-	Opacity       float64    `xml:"opacity,attr,omitempty"`
-	Attrs         []xml.Attr `xml:",attr"`
-	Children      []interface{}
-	lock          *sync.Mutex
+	XMLName   xml.Name
+	X1        *Length    `xml:"x1,attr,omitempty"`
+	Y1        *Length    `xml:"y1,attr,omitempty"`
+	X2        *Length    `xml:"x2,attr,omitempty"`
+	Y2        *Length    `xml:"y2,attr,omitempty"`
+	Transform *Transform `xml:"transform,attr,omitempty"`
+	Presentation
+	Attrs    []xml.Attr `xml:",attr"`
+	Children []interface{}
+	lock     *sync.Mutex
 }
 
 // L constructs new Line element (shortcut)
@@ -71,85 +67,16 @@ func NewLine(x1, y1, x2, y2 *Length, children ...interface{}) Line {
 	return l
 }
 
-// SetStrokeWidth sets the stroke width of a Line
-func (l Line) SetStrokeWidth(strokeWidth uint8) Line {
-	l.StrokeWidth = &strokeWidth
+// SetTransform sets the transform of a Line
+func (l Line) SetTransform(transform Transform) Line {
+	l.Transform = &transform
 
 	return l
 }
 
-// UnsetStrokeWidth removes the previously set stroke width of a Line
-func (l Line) UnsetStrokeWidth() Line {
-	l.StrokeWidth = nil
-
-	return l
-}
-
-// SetStroke sets the stroke color of a Line
-func (l Line) SetStroke(stroke Color) Line {
-	l.Stroke = &stroke
-
-	return l
-}
-
-// UnsetStroke removes the previously set stroke color of a Line
-func (l Line) UnsetStroke() Line {
-	l.Stroke = nil
-
-	return l
-}
-
-// SetStrokeOpacity sets the stroke opacity of a Line
-func (l Line) SetStrokeOpacity(so Opacity) Line {
-	l.StrokeOpacity = &so
-
-	return l
-}
-
-// SetStrokeOpacity removes the stroke opacity of a Line
-func (l Line) UnsetStrokeOpacity() Line {
-	l.StrokeOpacity = nil
-
-	return l
-}
-
-// SetStroke sets the fill color of a Line
-func (l Line) SetFill(fill Color) Line {
-	l.Fill = &fill
-
-	return l
-}
-
-// UnsetStroke removes the previously set fill color of a Line
-func (l Line) UnsetFill() Line {
-	l.Fill = nil
-
-	return l
-}
-
-// SetStrokeOpacity sets the fill opacity of a Line
-func (l Line) SetFillOpacity(fo Opacity) Line {
-	l.FillOpacity = &fo
-
-	return l
-}
-
-// SetStrokeOpacity removes the stroke opacity of a Line
-func (l Line) UnsetFillOpacity() Line {
-	l.FillOpacity = nil
-
-	return l
-}
-
-// SetOpacity sets the opacity of a Line
-func (l Line) SetOpacity(o float64) Line {
-	if o < 0 {
-		l.Opacity = 0
-	} else if o > 1 {
-		l.Opacity = 1
-	} else {
-		l.Opacity = o
-	}
+// UnsetTransform removes the previously set transform of a Line
+func (l Line) UnsetTransform() Line {
+	l.Transform = nil
 
 	return l
 }
@@ -177,3 +104,14 @@ func (l Line) RemoveAttr(name string) Line {
 
 	return l
 }
+
+// BBox returns the bounding box of the fill area of a Line, without its transform
+// Percentages are resolved against an empty viewport, use Geometry for other viewports.
+func (l Line) BBox() BBox {
+	return defaultGeometry.BBox(l)
+}
+
+// StrokeBBox returns the bounding box of a Line including its stroke, without its transform
+func (l Line) StrokeBBox() BBox {
+	return defaultGeometry.StrokeBBox(l)
+}
@@ -49,6 +49,18 @@ func TestLine_MarshalText(t *testing.T) {
 			[]string{`<line y1="100" x2="200" y2="150"></line>`},
 			false,
 		},
+		{
+			"hairline",
+			L(0, 100, 200, 150).SStrokeWidth(0.1),
+			[]string{`<line y1="100" x2="200" y2="150" stroke-width="0.1"></line>`},
+			false,
+		},
+		{
+			"stroke width in mm",
+			L(0, 0, 1, 1).SetStrokeWidth(Lth(0.1, Mm)),
+			[]string{`<line x2="1" y2="1" stroke-width="0.1mm"></line>`},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
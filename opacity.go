@@ -2,6 +2,7 @@ package svg
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 )
@@ -88,3 +89,13 @@ func (o Opacity) MarshalText() ([]byte, error) {
 
 	return []byte(s), nil
 }
+
+// Value returns an Opacity as a number between 0 (transparent) and 1 (opaque), e.g. 50% is 0.5
+func (o Opacity) Value() float64 {
+	v := o.Number
+	if o.Type == OPercent {
+		v /= 100
+	}
+
+	return math.Max(0, math.Min(1, v))
+}
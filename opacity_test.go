@@ -197,3 +197,23 @@ func TestOpacity_UnmarshalText(t *testing.T) {
 		})
 	}
 }
+
+func TestOpacity_Value(t *testing.T) {
+	tests := []struct {
+		name string
+		o    Opacity
+		want float64
+	}{
+		{"number", O(0.25), 0.25},
+		{"percent", O(40, OPercent), 0.4},
+		{"above one is clamped", O(1.5), 1},
+		{"negative is clamped", O(-20, OPercent), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.Value(); got != tt.want {
+				t.Errorf("Value() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
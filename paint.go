@@ -0,0 +1,146 @@
+package svg
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type PaintType string
+
+const (
+	PaintNone         PaintType = "none"
+	PaintCurrentColor PaintType = "currentColor"
+	PaintColor        PaintType = "color"
+	PaintURL          PaintType = "url"
+)
+
+// Paint represents the value of a fill or stroke attribute
+// It is either none, currentColor, a solid Color or a reference to a paint server, e.g. a gradient
+// See: https://www.w3.org/TR/SVG11/painting.html#SpecifyingPaint
+type Paint struct {
+	Type  PaintType
+	Color Color
+	// URL is the reference to the paint server, e.g. "#gradient"
+	URL string
+	// Fallback is used if the paint server referenced by URL can not be used
+	Fallback *Paint
+	// Format is the format Color is written in, ColorPaint and UnmarshalText set one keeping the alpha channel
+	Format ColorFormat
+}
+
+// ColorPaint constructs a Paint of a solid Color, translucent colors are written as rgba()
+func ColorPaint(c Color) Paint {
+	return Paint{Type: PaintColor, Color: c, Format: alphaFormat(c, "")}
+}
+
+// URLPaint constructs a Paint referencing the paint server with the given id
+func URLPaint(id string) Paint {
+	return Paint{Type: PaintURL, URL: "#" + id}
+}
+
+// NonePaint constructs a Paint which paints nothing
+func NonePaint() Paint {
+	return Paint{Type: PaintNone}
+}
+
+// CurrentColorPaint constructs a Paint which uses the value of the color property
+func CurrentColorPaint() Paint {
+	return Paint{Type: PaintCurrentColor}
+}
+
+// SetFallback sets the paint used if the paint server of a URL Paint can not be used
+// Only none, currentColor and solid colors are valid fallbacks
+func (p Paint) SetFallback(fallback Paint) Paint {
+	p.Fallback = &fallback
+
+	return p
+}
+
+// SetFormat sets the format the color of a Paint is written in, e.g. ColorRGBA to keep its alpha channel
+func (p Paint) SetFormat(f ColorFormat) Paint {
+	p.Format = f
+
+	return p
+}
+
+func (p Paint) String() string {
+	switch p.Type {
+	case PaintNone, PaintCurrentColor:
+		return string(p.Type)
+	case PaintColor:
+		return p.Color.Format(p.Format)
+	case PaintURL:
+		s := fmt.Sprintf("url(%s)", p.URL)
+		if p.Fallback != nil {
+			s += " " + p.Fallback.String()
+		}
+
+		return s
+	}
+
+	return ""
+}
+
+func (p *Paint) UnmarshalText(text []byte) error {
+	t := strings.TrimSpace(string(text))
+
+	switch strings.ToLower(t) {
+	case "":
+		return errors.New("empty paint")
+	case string(PaintNone):
+		*p = NonePaint()
+
+		return nil
+	case strings.ToLower(string(PaintCurrentColor)):
+		*p = CurrentColorPaint()
+
+		return nil
+	}
+
+	if strings.HasPrefix(t, "url(") {
+		end := strings.IndexByte(t, ')')
+		if end < 0 {
+			return fmt.Errorf("invalid paint, missing ')': %s", t)
+		}
+
+		res := Paint{Type: PaintURL, URL: strings.Trim(strings.TrimSpace(t[4:end]), `"'`)}
+		if res.URL == "" {
+			return fmt.Errorf("invalid paint, empty url: %s", t)
+		}
+
+		if rest := strings.TrimSpace(t[end+1:]); rest != "" {
+			var fallback Paint
+			if err := fallback.UnmarshalText([]byte(rest)); err != nil {
+				return err
+			}
+			if fallback.Type == PaintURL {
+				return fmt.Errorf("invalid paint fallback: %s", rest)
+			}
+			res.Fallback = &fallback
+		}
+
+		*p = res
+
+		return nil
+	}
+
+	var c Color
+	if err := c.UnmarshalText([]byte(t)); err != nil {
+		return err
+	}
+
+	*p = ColorPaint(c)
+	p.Format = alphaFormat(c, t)
+
+	return nil
+}
+
+func (p Paint) MarshalText() ([]byte, error) {
+	s := p.String()
+	if s == "" {
+		return nil, fmt.Errorf("invalid paint type: %s", p.Type)
+	}
+
+	return []byte(s), nil
+}
@@ -0,0 +1,147 @@
+package svg
+
+import (
+	"image/color"
+	"math"
+)
+
+// PaintStyle is the resolved style shapes and texts are painted with, lengths are in the current user space
+type PaintStyle struct {
+	// Fill and Stroke are nil if the shape is not filled or stroked, their alpha includes the fill and
+	// stroke opacities, Render applies the opacity of the element separately
+	Fill        *Color
+	Stroke      *Color
+	FillRule    FillRule
+	StrokeWidth float64
+	Linecap     StrokeLinecap
+	Linejoin    StrokeLinejoin
+	Miterlimit  float64
+	// Dashes holds the lengths of dashes and gaps, it is empty for solid strokes
+	Dashes     []float64
+	DashOffset float64
+}
+
+// black is the initial value of the fill property
+var black = Color{color.RGBA{A: 255}}
+
+// paintStyle returns the style a shape is painted with, it reports false if the shape is not painted at all
+func (gw *geometryWalker) paintStyle(leaf interface{}, sg shapeGeometry) (PaintStyle, bool) {
+	p := gw.presentation(leaf)
+	if p.Visibility != nil && *p.Visibility != VisibilityVisible {
+		return PaintStyle{}, false
+	}
+
+	ps := PaintStyle{
+		Fill:        paintColor(sg.Fill, &black, sg.FillOpacity),
+		Stroke:      paintColor(sg.Stroke, nil, sg.StrokeOpacity),
+		FillRule:    FillRuleNonZero,
+		StrokeWidth: sg.strokeWidth(gw.lr),
+		Linecap:     LinecapButt,
+		Linejoin:    LinejoinMiter,
+		Miterlimit:  4,
+	}
+
+	if ps.StrokeWidth <= 0 {
+		ps.Stroke = nil
+	}
+
+	if p.FillRule != nil {
+		ps.FillRule = *p.FillRule
+	}
+	if p.StrokeLinecap != nil {
+		ps.Linecap = *p.StrokeLinecap
+	}
+	if p.StrokeLinejoin != nil {
+		ps.Linejoin = *p.StrokeLinejoin
+	}
+	if p.StrokeMiterlimit != nil && *p.StrokeMiterlimit >= 1 {
+		ps.Miterlimit = *p.StrokeMiterlimit
+	}
+	if p.StrokeDashArray != nil {
+		ps.Dashes = dashes(*p.StrokeDashArray, gw.lr)
+		ps.DashOffset = gw.lr.resolve(p.StrokeDashOffset, AxisDiagonal)
+	}
+
+	return ps, ps.Fill != nil || ps.Stroke != nil
+}
+
+// elementOpacity returns the opacity of an element as a number between 0 and 1, elements without one are opaque
+func elementOpacity(o *Opacity) float64 {
+	if o == nil {
+		return 1
+	}
+
+	return o.Value()
+}
+
+// paintColor returns the Color painted by p with the opacity o applied, or nil if p paints nothing
+// A missing Paint paints def. Paint servers, e.g. gradients, are not supported, so URL paints use
+// their fallback if they have one. The color property is not supported either, so currentColor is black.
+func paintColor(p *Paint, def *Color, o *Opacity) *Color {
+	var c Color
+
+	switch {
+	case p == nil && def == nil:
+		return nil
+	case p == nil:
+		c = *def
+	case p.Type == PaintColor:
+		c = p.Color
+	case p.Type == PaintCurrentColor:
+		c = black
+	case p.Type == PaintURL && p.Fallback != nil:
+		return paintColor(p.Fallback, nil, o)
+	default:
+		return nil
+	}
+
+	opacity := 1.0
+	if o != nil {
+		opacity = o.Value()
+	}
+
+	return fade(&c, opacity)
+}
+
+// fade returns a copy of c with its alpha multiplied by opacity, or nil if c is nil or becomes transparent
+func fade(c *Color, opacity float64) *Color {
+	if c == nil {
+		return nil
+	}
+
+	faded := *c
+	faded.A = uint8(math.Round(float64(c.A) * opacity))
+	if faded.A == 0 {
+		return nil
+	}
+
+	return &faded
+}
+
+// dashes returns the lengths of the dashes and gaps of a DashArray in user units
+// An odd number of values is repeated to get an even number, invalid arrays result in a solid stroke.
+// See: https://www.w3.org/TR/SVG11/painting.html#StrokeDasharrayProperty
+func dashes(da DashArray, lr LengthResolver) []float64 {
+	res := make([]float64, 0, 2*len(da))
+
+	sum := 0.0
+	for _, l := range da {
+		d := lr.Resolve(l, AxisDiagonal)
+		if d < 0 {
+			return nil
+		}
+
+		res = append(res, d)
+		sum += d
+	}
+
+	if sum == 0 {
+		return nil
+	}
+
+	if len(res)%2 == 1 {
+		res = append(res, res...)
+	}
+
+	return res
+}
@@ -0,0 +1,100 @@
+package svg
+
+import (
+	"image/color"
+	"reflect"
+	"testing"
+)
+
+func TestPaintColor(t *testing.T) {
+	red := Red.ToColor()
+	half, zero := O(0.5), O(0)
+
+	tests := []struct {
+		name string
+		p    *Paint
+		def  *Color
+		o    *Opacity
+		want *Color
+	}{
+		{"missing paint uses the default", nil, &red, nil, &red},
+		{"missing paint without default", nil, nil, nil, nil},
+		{"none", &Paint{Type: PaintNone}, &red, nil, nil},
+		{"color", &Paint{Type: PaintColor, Color: red}, nil, nil, &red},
+		{"current color", &Paint{Type: PaintCurrentColor}, nil, nil, &black},
+		{"url without fallback", &Paint{Type: PaintURL, URL: "#g"}, &red, nil, nil},
+		{"url with fallback", &Paint{Type: PaintURL, URL: "#g", Fallback: &Paint{Type: PaintColor, Color: red}}, nil, nil, &red},
+		{"opacity", &Paint{Type: PaintColor, Color: red}, nil, &half, &Color{color.RGBA{255, 0, 0, 128}}},
+		{"transparent", &Paint{Type: PaintColor, Color: red}, nil, &zero, nil},
+		{"transparent color", &Paint{Type: PaintColor, Color: Color{}}, nil, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := paintColor(tt.p, tt.def, tt.o); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("paintColor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDashes(t *testing.T) {
+	lr := NewLengthResolver(100, 100)
+
+	tests := []struct {
+		name string
+		da   DashArray
+		want []float64
+	}{
+		{"even", DashArray{Lth(1), Lth(2)}, []float64{1, 2}},
+		{"odd is repeated", DashArray{Lth(1), Lth(2), Lth(3)}, []float64{1, 2, 3, 1, 2, 3}},
+		{"percent", DashArray{Lth(10, Percent)}, []float64{10, 10}},
+		{"negative", DashArray{Lth(1), Lth(-2)}, nil},
+		{"zero sum", DashArray{Lth(0), Lth(0)}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dashes(tt.da, lr); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("dashes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeometryWalker_paintStyle(t *testing.T) {
+	round, bevel, evenOdd := LinecapRound, LinejoinBevel, FillRuleEvenOdd
+	dash := DashArray{Lth(2)}
+
+	g := NewGroup(
+		R(0, 0, 1, 1).SetStroke(Red.ToColor()).SetStrokeLinejoin(LinejoinRound),
+	).SetPresentation(Presentation{
+		StrokeLinecap:   &round,
+		StrokeLinejoin:  &bevel,
+		FillRule:        &evenOdd,
+		StrokeDashArray: &dash,
+	}).SetFill(Blue.ToColor()).SStrokeWidth(3)
+
+	gw := newGeometryWalker(NewLengthResolver(0, 0), false, g)
+
+	var got PaintStyle
+	gw.visit(g, IdentityMatrix(), func(leaf interface{}, m Matrix) bool {
+		sg, _ := gw.geometry(leaf)
+		got, _ = gw.paintStyle(leaf, sg)
+
+		return true
+	})
+
+	red, blue := Red.ToColor(), Blue.ToColor()
+	want := PaintStyle{
+		Fill:        &blue,
+		Stroke:      &red,
+		FillRule:    FillRuleEvenOdd,
+		StrokeWidth: 3,
+		Linecap:     LinecapRound,
+		Linejoin:    LinejoinRound,
+		Miterlimit:  4,
+		Dashes:      []float64{2, 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("paintStyle() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,116 @@
+package svg
+
+import (
+	"encoding/xml"
+	"image/color"
+	"reflect"
+	"testing"
+)
+
+func TestPaint_MarshalText(t *testing.T) {
+	red := Color{color.RGBA{R: 255, A: 255}}
+	fallback := ColorPaint(red)
+
+	tests := []struct {
+		name    string
+		p       Paint
+		want    string
+		wantErr bool
+	}{
+		{"none", NonePaint(), "none", false},
+		{"current color", CurrentColorPaint(), "currentColor", false},
+		{"color", ColorPaint(red), "#ff0000", false},
+		{"url", URLPaint("grad"), "url(#grad)", false},
+		{"url with fallback", URLPaint("grad").SetFallback(fallback), "url(#grad) #ff0000", false},
+		{"rgba format", ColorPaint(Color{color.RGBA{R: 255, A: 51}}).SetFormat(ColorRGBA), "rgba(255, 0, 0, 0.2)", false},
+		{"hex alpha format", ColorPaint(Color{color.RGBA{R: 255, A: 51}}).SetFormat(ColorHexAlpha), "#ff000033", false},
+		{"fallback with format", URLPaint("grad").SetFallback(ColorPaint(Color{color.RGBA{R: 255, A: 51}}).SetFormat(ColorHexAlpha)), "url(#grad) #ff000033", false},
+		{"keyword format", ColorPaint(red).SetFormat(ColorKeyword), "red", false},
+		{"invalid type", Paint{}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.p.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalText() got = %v, want %v", string(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestPaint_UnmarshalText(t *testing.T) {
+	red := Color{color.RGBA{R: 255, A: 255}}
+	none := NonePaint()
+
+	tests := []struct {
+		name    string
+		text    string
+		want    Paint
+		wantErr bool
+	}{
+		{"none", "none", NonePaint(), false},
+		{"current color", "currentcolor", CurrentColorPaint(), false},
+		{"color name", "red", ColorPaint(red), false},
+		{"hexa color", " #f00 ", ColorPaint(red), false},
+		{"translucent hexa color", "#ff000080", Paint{Type: PaintColor, Color: Color{color.RGBA{R: 255, A: 128}}, Format: ColorHexAlpha}, false},
+		{"translucent rgba color", "rgba(255, 0, 0, 0.5)", Paint{Type: PaintColor, Color: Color{color.RGBA{R: 255, A: 128}}, Format: ColorRGBA}, false},
+		{"url", "url(#grad)", URLPaint("grad"), false},
+		{"quoted url", `url( "#grad" )`, URLPaint("grad"), false},
+		{"url with fallback", "url(#grad) none", Paint{Type: PaintURL, URL: "#grad", Fallback: &none}, false},
+		{"url with url fallback", "url(#grad) url(#other)", Paint{}, true},
+		{"unclosed url", "url(#grad", Paint{}, true},
+		{"empty url", "url()", Paint{}, true},
+		{"empty", "", Paint{}, true},
+		{"invalid", "bogus", Paint{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Paint
+			err := got.UnmarshalText([]byte(tt.text))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnmarshalText() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaint_Shapes(t *testing.T) {
+	grad := NewLinearGradient("grad")
+
+	tests := []struct {
+		name string
+		el   interface{}
+		want string
+	}{
+		{
+			"circle with gradient fill",
+			C(5, 5, 5).SetFillPaint(grad.Paint()).SetStrokePaint(NonePaint()),
+			`<circle cx="5" cy="5" r="5" stroke="none" fill="url(#grad)"></circle>`,
+		},
+		{
+			"rect with current color stroke",
+			R(0, 0, 5, 5).SetStrokePaint(CurrentColorPaint()),
+			`<rect width="5" height="5" stroke="currentColor"></rect>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBytes, err := xml.Marshal(tt.el)
+			if err != nil {
+				t.Errorf("xml.Marshal() error = %v", err)
+				return
+			}
+			if got := string(gotBytes); got != tt.want {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
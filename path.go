@@ -0,0 +1,99 @@
+package svg
+
+import (
+	"encoding/xml"
+	"sync"
+)
+
+// Path represents a Path SVG element
+// See: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/path
+type Path struct {
+	XMLName   xml.Name
+	D         *PathData  `xml:"d,attr,omitempty"`
+	Transform *Transform `xml:"transform,attr,omitempty"`
+	Presentation
+	Attrs    []xml.Attr `xml:",attr"`
+	Children []interface{}
+	lock     *sync.Mutex
+}
+
+// P constructs new Path element (shortcut)
+func P(d PathData, children ...interface{}) Path {
+	return NewPath(&d, children...)
+}
+
+// NewPath constructs new Path element
+func NewPath(d *PathData, children ...interface{}) Path {
+	p := Path{
+		XMLName: xml.Name{Local: "path"},
+		D:       d,
+		lock:    &sync.Mutex{},
+	}
+
+	p.Children = append(p.Children, children...)
+
+	return p
+}
+
+// SetD sets the path data of a Path
+func (p Path) SetD(d PathData) Path {
+	p.D = &d
+
+	return p
+}
+
+// UnsetD removes the previously set path data of a Path
+func (p Path) UnsetD() Path {
+	p.D = nil
+
+	return p
+}
+
+// SetTransform sets the transform of a Path
+func (p Path) SetTransform(transform Transform) Path {
+	p.Transform = &transform
+
+	return p
+}
+
+// UnsetTransform removes the previously set transform of a Path
+func (p Path) UnsetTransform() Path {
+	p.Transform = nil
+
+	return p
+}
+
+// AddAttr adds a new attribute to a Path
+func (p Path) AddAttr(name, value string) Path {
+	p.lock.Lock()
+	p.Attrs = append(p.Attrs, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+	p.lock.Unlock()
+
+	return p
+}
+
+// RemoveAttr removes all attributes of a given name of a Path
+func (p Path) RemoveAttr(name string) Path {
+	p.lock.Lock()
+	attrs := []xml.Attr{}
+	for _, attr := range p.Attrs {
+		if attr.Name.Local != name {
+			attrs = append(attrs, attr)
+		}
+	}
+	p.Attrs = attrs
+	p.lock.Unlock()
+
+	return p
+}
+
+// BBox returns the bounding box of the fill area of a Path, without its transform
+// Percentages are resolved against an empty viewport, use Geometry for other viewports.
+func (p Path) BBox() BBox {
+	return defaultGeometry.BBox(p)
+}
+
+// StrokeBBox returns the bounding box of a Path including its stroke, without its transform
+func (p Path) StrokeBBox() BBox {
+	return defaultGeometry.StrokeBBox(p)
+}
@@ -0,0 +1,249 @@
+package svg
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PathCommandType is the letter of an SVG path command, e.g. 'M' or 'c'
+// Uppercase letters are absolute, lowercase letters are relative commands
+type PathCommandType byte
+
+const (
+	MoveToAbs        PathCommandType = 'M'
+	MoveToRel        PathCommandType = 'm'
+	LineToAbs        PathCommandType = 'L'
+	LineToRel        PathCommandType = 'l'
+	HLineToAbs       PathCommandType = 'H'
+	HLineToRel       PathCommandType = 'h'
+	VLineToAbs       PathCommandType = 'V'
+	VLineToRel       PathCommandType = 'v'
+	CurveToAbs       PathCommandType = 'C'
+	CurveToRel       PathCommandType = 'c'
+	SmoothCurveToAbs PathCommandType = 'S'
+	SmoothCurveToRel PathCommandType = 's'
+	QuadToAbs        PathCommandType = 'Q'
+	QuadToRel        PathCommandType = 'q'
+	SmoothQuadToAbs  PathCommandType = 'T'
+	SmoothQuadToRel  PathCommandType = 't'
+	ArcToAbs         PathCommandType = 'A'
+	ArcToRel         PathCommandType = 'a'
+	ClosePathAbs     PathCommandType = 'Z'
+	ClosePathRel     PathCommandType = 'z'
+)
+
+const pathCommandLetters = "MmLlHhVvCcSsQqTtAaZz"
+
+func (t PathCommandType) String() string {
+	if !t.IsValid() {
+		return ""
+	}
+
+	return string(t)
+}
+
+// IsValid checks if a PathCommandType is a known SVG path command
+func (t PathCommandType) IsValid() bool {
+	return t != 0 && strings.IndexByte(pathCommandLetters, byte(t)) > -1
+}
+
+// IsRelative checks if a PathCommandType is a relative command
+func (t PathCommandType) IsRelative() bool {
+	return t >= 'a' && t <= 'z'
+}
+
+// ParamCount returns the number of parameters a single PathCommandType takes
+func (t PathCommandType) ParamCount() int {
+	switch t {
+	case MoveToAbs, MoveToRel, LineToAbs, LineToRel, SmoothQuadToAbs, SmoothQuadToRel:
+		return 2
+	case HLineToAbs, HLineToRel, VLineToAbs, VLineToRel:
+		return 1
+	case CurveToAbs, CurveToRel:
+		return 6
+	case SmoothCurveToAbs, SmoothCurveToRel, QuadToAbs, QuadToRel:
+		return 4
+	case ArcToAbs, ArcToRel:
+		return 7
+	}
+
+	return 0
+}
+
+// PathCommand represents a single command of SVG path data
+// See: https://www.w3.org/TR/SVG11/paths.html#PathData
+type PathCommand struct {
+	Type   PathCommandType
+	Params []float64
+}
+
+func (pc PathCommand) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(pc.Type.String())
+
+	for i, p := range pc.Params {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(fmt.Sprintf("%v", p))
+	}
+
+	return sb.String()
+}
+
+// PathData represents the value of the d attribute of a Path
+// See: https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/d
+type PathData struct {
+	Commands []PathCommand
+}
+
+// NewPathData constructs new PathData
+func NewPathData(commands ...PathCommand) PathData {
+	pd := PathData{}
+
+	pd.Commands = append(pd.Commands, commands...)
+
+	return pd
+}
+
+func (pd PathData) add(t PathCommandType, params ...float64) PathData {
+	// the full slice expression forces a copy so that builders branching off
+	// from the same PathData do not overwrite each other's commands
+	pd.Commands = append(pd.Commands[:len(pd.Commands):len(pd.Commands)], PathCommand{Type: t, Params: params})
+
+	return pd
+}
+
+// MoveTo adds an absolute moveto (M) command to a PathData
+func (pd PathData) MoveTo(x, y float64) PathData {
+	return pd.add(MoveToAbs, x, y)
+}
+
+// MoveToRel adds a relative moveto (m) command to a PathData
+func (pd PathData) MoveToRel(dx, dy float64) PathData {
+	return pd.add(MoveToRel, dx, dy)
+}
+
+// LineTo adds an absolute lineto (L) command to a PathData
+func (pd PathData) LineTo(x, y float64) PathData {
+	return pd.add(LineToAbs, x, y)
+}
+
+// LineToRel adds a relative lineto (l) command to a PathData
+func (pd PathData) LineToRel(dx, dy float64) PathData {
+	return pd.add(LineToRel, dx, dy)
+}
+
+// H adds an absolute horizontal lineto (H) command to a PathData
+func (pd PathData) H(x float64) PathData {
+	return pd.add(HLineToAbs, x)
+}
+
+// HRel adds a relative horizontal lineto (h) command to a PathData
+func (pd PathData) HRel(dx float64) PathData {
+	return pd.add(HLineToRel, dx)
+}
+
+// V adds an absolute vertical lineto (V) command to a PathData
+func (pd PathData) V(y float64) PathData {
+	return pd.add(VLineToAbs, y)
+}
+
+// VRel adds a relative vertical lineto (v) command to a PathData
+func (pd PathData) VRel(dy float64) PathData {
+	return pd.add(VLineToRel, dy)
+}
+
+// CurveTo adds an absolute cubic Bézier curveto (C) command to a PathData
+func (pd PathData) CurveTo(x1, y1, x2, y2, x, y float64) PathData {
+	return pd.add(CurveToAbs, x1, y1, x2, y2, x, y)
+}
+
+// CurveToRel adds a relative cubic Bézier curveto (c) command to a PathData
+func (pd PathData) CurveToRel(dx1, dy1, dx2, dy2, dx, dy float64) PathData {
+	return pd.add(CurveToRel, dx1, dy1, dx2, dy2, dx, dy)
+}
+
+// SmoothCurveTo adds an absolute smooth cubic Bézier curveto (S) command to a PathData
+func (pd PathData) SmoothCurveTo(x2, y2, x, y float64) PathData {
+	return pd.add(SmoothCurveToAbs, x2, y2, x, y)
+}
+
+// SmoothCurveToRel adds a relative smooth cubic Bézier curveto (s) command to a PathData
+func (pd PathData) SmoothCurveToRel(dx2, dy2, dx, dy float64) PathData {
+	return pd.add(SmoothCurveToRel, dx2, dy2, dx, dy)
+}
+
+// QuadTo adds an absolute quadratic Bézier curveto (Q) command to a PathData
+func (pd PathData) QuadTo(x1, y1, x, y float64) PathData {
+	return pd.add(QuadToAbs, x1, y1, x, y)
+}
+
+// QuadToRel adds a relative quadratic Bézier curveto (q) command to a PathData
+func (pd PathData) QuadToRel(dx1, dy1, dx, dy float64) PathData {
+	return pd.add(QuadToRel, dx1, dy1, dx, dy)
+}
+
+// SmoothQuadTo adds an absolute smooth quadratic Bézier curveto (T) command to a PathData
+func (pd PathData) SmoothQuadTo(x, y float64) PathData {
+	return pd.add(SmoothQuadToAbs, x, y)
+}
+
+// SmoothQuadToRel adds a relative smooth quadratic Bézier curveto (t) command to a PathData
+func (pd PathData) SmoothQuadToRel(dx, dy float64) PathData {
+	return pd.add(SmoothQuadToRel, dx, dy)
+}
+
+// ArcTo adds an absolute elliptical arc (A) command to a PathData
+func (pd PathData) ArcTo(rx, ry, xAxisRotation float64, largeArc, sweep bool, x, y float64) PathData {
+	return pd.add(ArcToAbs, rx, ry, xAxisRotation, boolToFlag(largeArc), boolToFlag(sweep), x, y)
+}
+
+// ArcToRel adds a relative elliptical arc (a) command to a PathData
+func (pd PathData) ArcToRel(rx, ry, xAxisRotation float64, largeArc, sweep bool, dx, dy float64) PathData {
+	return pd.add(ArcToRel, rx, ry, xAxisRotation, boolToFlag(largeArc), boolToFlag(sweep), dx, dy)
+}
+
+// ClosePath adds a closepath (Z) command to a PathData
+func (pd PathData) ClosePath() PathData {
+	return pd.add(ClosePathAbs)
+}
+
+// ClosePathRel adds a closepath (z) command to a PathData
+func (pd PathData) ClosePathRel() PathData {
+	return pd.add(ClosePathRel)
+}
+
+func (pd PathData) String() string {
+	s := make([]string, 0, len(pd.Commands))
+
+	for _, c := range pd.Commands {
+		s = append(s, c.String())
+	}
+
+	return strings.Join(s, " ")
+}
+
+func (pd PathData) MarshalText() ([]byte, error) {
+	for _, c := range pd.Commands {
+		if !c.Type.IsValid() {
+			return nil, fmt.Errorf("invalid path command: %q", byte(c.Type))
+		}
+		if len(c.Params) != c.Type.ParamCount() {
+			return nil, fmt.Errorf("invalid number of parameters for path command %s: %d", c.Type, len(c.Params))
+		}
+	}
+
+	s := pd.String()
+
+	return []byte(s), nil
+}
+
+func boolToFlag(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
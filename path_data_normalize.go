@@ -0,0 +1,233 @@
+package svg
+
+import (
+	"math"
+)
+
+// ToAbsolute converts all relative commands of a PathData to their absolute counterparts
+// The command types are kept otherwise, so h becomes H and a becomes A
+func (pd PathData) ToAbsolute() PathData {
+	res := PathData{Commands: make([]PathCommand, 0, len(pd.Commands))}
+
+	var cx, cy, sx, sy float64
+
+	for _, c := range pd.Commands {
+		params := append([]float64(nil), c.Params...)
+		t := c.Type
+
+		if t.IsRelative() {
+			t = PathCommandType(byte(t) - 'a' + 'A')
+
+			switch t {
+			case HLineToAbs:
+				params[0] += cx
+			case VLineToAbs:
+				params[0] += cy
+			case ArcToAbs:
+				params[5] += cx
+				params[6] += cy
+			default:
+				for i := 0; i+1 < len(params); i += 2 {
+					params[i] += cx
+					params[i+1] += cy
+				}
+			}
+		}
+
+		switch t {
+		case ClosePathAbs:
+			cx, cy = sx, sy
+		case HLineToAbs:
+			cx = params[0]
+		case VLineToAbs:
+			cy = params[0]
+		case MoveToAbs:
+			cx, cy = params[0], params[1]
+			sx, sy = cx, cy
+		default:
+			if len(params) >= 2 {
+				cx, cy = params[len(params)-2], params[len(params)-1]
+			}
+		}
+
+		res.Commands = append(res.Commands, PathCommand{Type: t, Params: params})
+	}
+
+	return res
+}
+
+// Normalize converts a PathData into a canonical form which only consists of absolute
+// M, L, C, Q and Z commands. H and V become L, S becomes C, T becomes Q and elliptical
+// arcs are approximated by cubic Bézier curves.
+func (pd PathData) Normalize() PathData {
+	abs := pd.ToAbsolute()
+
+	res := PathData{Commands: make([]PathCommand, 0, len(abs.Commands))}
+
+	var (
+		cx, cy, sx, sy float64
+		// reflected control points of the previous curve, if any
+		ccx, ccy, qcx, qcy float64
+		prev               PathCommandType
+	)
+
+	for _, c := range abs.Commands {
+		p := c.Params
+
+		switch c.Type {
+		case MoveToAbs:
+			res = res.MoveTo(p[0], p[1])
+			cx, cy = p[0], p[1]
+			sx, sy = cx, cy
+		case LineToAbs:
+			res = res.LineTo(p[0], p[1])
+			cx, cy = p[0], p[1]
+		case HLineToAbs:
+			res = res.LineTo(p[0], cy)
+			cx = p[0]
+		case VLineToAbs:
+			res = res.LineTo(cx, p[0])
+			cy = p[0]
+		case CurveToAbs:
+			res = res.CurveTo(p[0], p[1], p[2], p[3], p[4], p[5])
+			ccx, ccy = p[2], p[3]
+			cx, cy = p[4], p[5]
+		case SmoothCurveToAbs:
+			x1, y1 := cx, cy
+			if prev == CurveToAbs || prev == SmoothCurveToAbs {
+				x1, y1 = 2*cx-ccx, 2*cy-ccy
+			}
+			res = res.CurveTo(x1, y1, p[0], p[1], p[2], p[3])
+			ccx, ccy = p[0], p[1]
+			cx, cy = p[2], p[3]
+		case QuadToAbs:
+			res = res.QuadTo(p[0], p[1], p[2], p[3])
+			qcx, qcy = p[0], p[1]
+			cx, cy = p[2], p[3]
+		case SmoothQuadToAbs:
+			x1, y1 := cx, cy
+			if prev == QuadToAbs || prev == SmoothQuadToAbs {
+				x1, y1 = 2*cx-qcx, 2*cy-qcy
+			}
+			res = res.QuadTo(x1, y1, p[0], p[1])
+			qcx, qcy = x1, y1
+			cx, cy = p[0], p[1]
+		case ArcToAbs:
+			res.Commands = append(res.Commands, arcToCubics(cx, cy, p[0], p[1], p[2], p[3] != 0, p[4] != 0, p[5], p[6])...)
+			cx, cy = p[5], p[6]
+		case ClosePathAbs:
+			res = res.ClosePath()
+			cx, cy = sx, sy
+		}
+
+		prev = c.Type
+	}
+
+	return res
+}
+
+// arcToCubics approximates an elliptical arc with cubic Bézier curves, using
+// the endpoint to center parameterization conversion of the SVG specification
+// See: https://www.w3.org/TR/SVG11/implnote.html#ArcImplementationNotes
+func arcToCubics(x1, y1, rx, ry, phiDeg float64, largeArc, sweep bool, x2, y2 float64) []PathCommand {
+	// F.6.2: identical endpoints omit the arc entirely
+	if x1 == x2 && y1 == y2 {
+		return nil
+	}
+
+	// F.6.6 step 1: zero radii mean a straight line
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 {
+		return []PathCommand{{Type: LineToAbs, Params: []float64{x2, y2}}}
+	}
+
+	phi := phiDeg * math.Pi / 180
+	sinPhi, cosPhi := math.Sincos(phi)
+
+	// F.6.5 step 1: compute (x1', y1')
+	dx, dy := (x1-x2)/2, (y1-y2)/2
+	x1p := cosPhi*dx + sinPhi*dy
+	y1p := -sinPhi*dx + cosPhi*dy
+
+	// F.6.6 step 3: scale up out-of-range radii
+	lambda := (x1p*x1p)/(rx*rx) + (y1p*y1p)/(ry*ry)
+	if lambda > 1 {
+		s := math.Sqrt(lambda)
+		rx, ry = rx*s, ry*s
+	}
+
+	// F.6.5 step 2: compute (cx', cy')
+	rx2, ry2 := rx*rx, ry*ry
+	num := rx2*ry2 - rx2*y1p*y1p - ry2*x1p*x1p
+	den := rx2*y1p*y1p + ry2*x1p*x1p
+	coef := 0.0
+	if num > 0 && den > 0 {
+		coef = math.Sqrt(num / den)
+	}
+	if largeArc == sweep {
+		coef = -coef
+	}
+	cxp := coef * rx * y1p / ry
+	cyp := -coef * ry * x1p / rx
+
+	// F.6.5 step 3: compute (cx, cy)
+	cx := cosPhi*cxp - sinPhi*cyp + (x1+x2)/2
+	cy := sinPhi*cxp + cosPhi*cyp + (y1+y2)/2
+
+	// F.6.5 step 4: compute the start angle and the sweep
+	theta1 := vectorAngle(1, 0, (x1p-cxp)/rx, (y1p-cyp)/ry)
+	dTheta := vectorAngle((x1p-cxp)/rx, (y1p-cyp)/ry, (-x1p-cxp)/rx, (-y1p-cyp)/ry)
+	if !sweep && dTheta > 0 {
+		dTheta -= 2 * math.Pi
+	} else if sweep && dTheta < 0 {
+		dTheta += 2 * math.Pi
+	}
+
+	// split the arc into segments of at most 90 degrees each
+	segments := int(math.Ceil(math.Abs(dTheta) / (math.Pi / 2)))
+	if segments < 1 {
+		segments = 1
+	}
+	delta := dTheta / float64(segments)
+	k := 4.0 / 3.0 * math.Tan(delta/4)
+
+	point := func(theta float64) (float64, float64) {
+		sin, cos := math.Sincos(theta)
+		return cx + rx*cos*cosPhi - ry*sin*sinPhi, cy + rx*cos*sinPhi + ry*sin*cosPhi
+	}
+	derivative := func(theta float64) (float64, float64) {
+		sin, cos := math.Sincos(theta)
+		return -rx*sin*cosPhi - ry*cos*sinPhi, -rx*sin*sinPhi + ry*cos*cosPhi
+	}
+
+	res := make([]PathCommand, 0, segments)
+
+	theta := theta1
+	px, py := x1, y1
+	for i := 0; i < segments; i++ {
+		next := theta + delta
+		ex, ey := point(next)
+		if i == segments-1 {
+			// avoid rounding errors at the end point
+			ex, ey = x2, y2
+		}
+
+		d1x, d1y := derivative(theta)
+		d2x, d2y := derivative(next)
+
+		res = append(res, PathCommand{
+			Type:   CurveToAbs,
+			Params: []float64{px + k*d1x, py + k*d1y, ex - k*d2x, ey - k*d2y, ex, ey},
+		})
+
+		theta = next
+		px, py = ex, ey
+	}
+
+	return res
+}
+
+// vectorAngle returns the signed angle between the vectors (ux, uy) and (vx, vy)
+func vectorAngle(ux, uy, vx, vy float64) float64 {
+	return math.Atan2(ux*vy-uy*vx, ux*vx+uy*vy)
+}
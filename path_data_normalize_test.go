@@ -0,0 +1,155 @@
+package svg
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestPathData_ToAbsolute(t *testing.T) {
+	tests := []struct {
+		name string
+		pd   string
+		want string
+	}{
+		{
+			"absolute stays absolute",
+			"M1 2 L3 4",
+			"M1 2 L3 4",
+		},
+		{
+			"relative commands",
+			"m10 10 l5 -5 h5 v5 c1 1 2 2 3 3 s1 1 2 2 q1 1 2 2 t1 1 a3 3 0 1 0 6 0 z",
+			"M10 10 L15 5 H20 V10 C21 11 22 12 23 13 S24 14 25 15 Q26 16 27 17 T28 18 A3 3 0 1 0 34 18 Z",
+		},
+		{
+			"relative moveto after closepath",
+			"M10 10 l5 0 z m1 1 l1 1",
+			"M10 10 L15 10 Z M11 11 L12 12",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pd, err := ParsePathData(tt.pd)
+			if err != nil {
+				t.Fatalf("ParsePathData() error = %v", err)
+			}
+			if got := pd.ToAbsolute().String(); got != tt.want {
+				t.Errorf("ToAbsolute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPathData_Normalize(t *testing.T) {
+	tests := []struct {
+		name string
+		pd   string
+		want string
+	}{
+		{
+			"lines",
+			"M10 10 H20 v10 l-10 0 z",
+			"M10 10 L20 10 L20 20 L10 20 Z",
+		},
+		{
+			"smooth cubic after cubic",
+			"M0 0 C0 10 10 10 10 0 S20 -10 20 0",
+			"M0 0 C0 10 10 10 10 0 C10 -10 20 -10 20 0",
+		},
+		{
+			"smooth cubic without previous cubic",
+			"M0 0 S20 -10 20 0",
+			"M0 0 C0 0 20 -10 20 0",
+		},
+		{
+			"smooth quadratic",
+			"M0 0 Q5 10 10 0 T20 0 t10 0",
+			"M0 0 Q5 10 10 0 Q15 -10 20 0 Q25 10 30 0",
+		},
+		{
+			"zero radius arc",
+			"M0 0 A0 5 0 0 1 10 0",
+			"M0 0 L10 0",
+		},
+		{
+			"arc to the current point",
+			"M0 0 A5 5 0 0 1 0 0",
+			"M0 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pd, err := ParsePathData(tt.pd)
+			if err != nil {
+				t.Fatalf("ParsePathData() error = %v", err)
+			}
+			if got := pd.Normalize().String(); got != tt.want {
+				t.Errorf("Normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPathData_Normalize_arc(t *testing.T) {
+	tests := []struct {
+		name     string
+		pd       PathData
+		segments int
+		// a point which must be on the resulting curve
+		mx, my float64
+	}{
+		{
+			"half circle, sweep",
+			NewPathData().MoveTo(0, 0).ArcTo(1, 1, 0, false, true, 2, 0),
+			2,
+			1, -1,
+		},
+		{
+			"half circle, no sweep",
+			NewPathData().MoveTo(0, 0).ArcTo(1, 1, 0, false, false, 2, 0),
+			2,
+			1, 1,
+		},
+		{
+			"radius too small is scaled up",
+			NewPathData().MoveTo(0, 0).ArcTo(0.5, 0.5, 0, false, true, 2, 0),
+			2,
+			1, -1,
+		},
+		{
+			"large arc of a circle",
+			NewPathData().MoveTo(0, 0).ArcToRel(10, 10, 0, true, true, 10, 10),
+			3,
+			20, 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.pd.Normalize()
+
+			if len(got.Commands) != tt.segments+1 {
+				t.Fatalf("Normalize() = %v, want %d curves", got, tt.segments)
+			}
+
+			last := got.Commands[len(got.Commands)-1]
+			wantEnd := tt.pd.ToAbsolute().Commands[1].Params[5:]
+			if !reflect.DeepEqual(last.Params[4:], wantEnd) {
+				t.Errorf("Normalize() end point = %v, want %v", last.Params[4:], wantEnd)
+			}
+
+			found := false
+			for _, c := range got.Commands[1:] {
+				if c.Type != CurveToAbs {
+					t.Fatalf("Normalize() = %v, want only curves", got)
+				}
+				if math.Abs(c.Params[4]-tt.mx) < 1e-9 && math.Abs(c.Params[5]-tt.my) < 1e-9 {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("Normalize() = %v, want curve ending in %v,%v", got, tt.mx, tt.my)
+			}
+		})
+	}
+}
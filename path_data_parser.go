@@ -0,0 +1,219 @@
+package svg
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// PathDataError is returned when path data can not be parsed
+type PathDataError struct {
+	Offset int
+	Msg    string
+}
+
+func (e *PathDataError) Error() string {
+	return fmt.Sprintf("invalid path data at offset %d: %s", e.Offset, e.Msg)
+}
+
+// renameParseError rewrites errors of the shared number list parser for values other than path data
+func renameParseError(subject string, err error) error {
+	var pdErr *PathDataError
+	if errors.As(err, &pdErr) {
+		return fmt.Errorf("invalid %s at offset %d: %s", subject, pdErr.Offset, pdErr.Msg)
+	}
+
+	return err
+}
+
+// ParsePathData parses the value of a d attribute into PathData
+// Implicit command repetitions are stored as separate commands, so "M1 2 3 4" results in "M1 2 L3 4"
+// See: https://www.w3.org/TR/SVG11/paths.html#PathDataBNF
+func ParsePathData(s string) (PathData, error) {
+	p := pathDataParser{s: s}
+
+	return p.parse()
+}
+
+func (pd *PathData) UnmarshalText(text []byte) error {
+	newPathData, err := ParsePathData(string(text))
+	if err != nil {
+		return err
+	}
+
+	*pd = newPathData
+
+	return nil
+}
+
+type pathDataParser struct {
+	s   string
+	pos int
+}
+
+func (p *pathDataParser) parse() (PathData, error) {
+	pd := PathData{}
+
+	var (
+		t      PathCommandType
+		params [7]float64
+	)
+
+	for {
+		p.skipWsp()
+		if p.eof() {
+			break
+		}
+
+		if c := PathCommandType(p.s[p.pos]); c.IsValid() {
+			if len(pd.Commands) == 0 && c != MoveToAbs && c != MoveToRel {
+				return pd, p.errorf("path data must start with a moveto command, got %q", p.s[p.pos])
+			}
+			t = c
+			p.pos++
+		} else if t == 0 {
+			return pd, p.errorf("path data must start with a moveto command, got %q", p.s[p.pos])
+		} else if t == ClosePathAbs || t == ClosePathRel {
+			return pd, p.errorf("unexpected %q after closepath", p.s[p.pos])
+		} else {
+			// implicit repetition of the previous command, moveto turns into lineto
+			switch t {
+			case MoveToAbs:
+				t = LineToAbs
+			case MoveToRel:
+				t = LineToRel
+			}
+		}
+
+		n := t.ParamCount()
+		for i := 0; i < n; i++ {
+			if i > 0 {
+				p.skipCommaWsp()
+			} else {
+				p.skipWsp()
+			}
+
+			var err error
+			if (t == ArcToAbs || t == ArcToRel) && (i == 3 || i == 4) {
+				params[i], err = p.flag()
+			} else {
+				params[i], err = p.number()
+			}
+			if err != nil {
+				return pd, err
+			}
+		}
+
+		pd.Commands = append(pd.Commands, PathCommand{Type: t, Params: append([]float64(nil), params[:n]...)})
+
+		if n > 0 {
+			p.skipCommaWsp()
+		}
+	}
+
+	return pd, nil
+}
+
+func (p *pathDataParser) eof() bool {
+	return p.pos >= len(p.s)
+}
+
+func (p *pathDataParser) errorf(format string, a ...interface{}) *PathDataError {
+	return &PathDataError{Offset: p.pos, Msg: fmt.Sprintf(format, a...)}
+}
+
+func (p *pathDataParser) skipWsp() {
+	for !p.eof() && isPathWsp(p.s[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *pathDataParser) skipCommaWsp() {
+	p.skipWsp()
+	if !p.eof() && p.s[p.pos] == ',' {
+		p.pos++
+		p.skipWsp()
+	}
+}
+
+func (p *pathDataParser) flag() (float64, error) {
+	if p.eof() {
+		return 0, p.errorf("unexpected end of path data, expected flag")
+	}
+
+	switch p.s[p.pos] {
+	case '0':
+		p.pos++
+		return 0, nil
+	case '1':
+		p.pos++
+		return 1, nil
+	}
+
+	return 0, p.errorf("invalid flag %q", p.s[p.pos])
+}
+
+// number reads a single number, stopping at the first character which can
+// not continue it, so that "1.5.5" is read as 1.5 and .5 and "1-2" as 1 and -2
+func (p *pathDataParser) number() (float64, error) {
+	start := p.pos
+
+	if p.eof() {
+		return 0, p.errorf("unexpected end of path data, expected number")
+	}
+
+	if c := p.s[p.pos]; c == '+' || c == '-' {
+		p.pos++
+	}
+
+	digits := p.digits()
+	if !p.eof() && p.s[p.pos] == '.' {
+		p.pos++
+		digits += p.digits()
+	}
+
+	if digits == 0 {
+		p.pos = start
+		if p.eof() {
+			return 0, p.errorf("unexpected end of path data, expected number")
+		}
+
+		return 0, p.errorf("invalid number starting with %q", p.s[p.pos])
+	}
+
+	if !p.eof() && (p.s[p.pos] == 'e' || p.s[p.pos] == 'E') {
+		// only consume the exponent if it is complete, otherwise the "e" is left alone
+		mark := p.pos
+		p.pos++
+		if !p.eof() && (p.s[p.pos] == '+' || p.s[p.pos] == '-') {
+			p.pos++
+		}
+		if p.digits() == 0 {
+			p.pos = mark
+		}
+	}
+
+	text := p.s[start:p.pos]
+
+	n, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		p.pos = start
+
+		return 0, p.errorf("invalid number %q", text)
+	}
+
+	return n, nil
+}
+
+func (p *pathDataParser) digits() int {
+	start := p.pos
+	for !p.eof() && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+
+	return p.pos - start
+}
+
+func isPathWsp(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
@@ -0,0 +1,171 @@
+package svg
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParsePathData(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    PathData
+		wantErr bool
+	}{
+		{
+			"empty",
+			"",
+			PathData{},
+			false,
+		},
+		{
+			"whitespace only",
+			" \t\n",
+			PathData{},
+			false,
+		},
+		{
+			"compact path",
+			"M10 10l5-5a3 3 0 1 0 6 0z",
+			NewPathData().MoveTo(10, 10).LineToRel(5, -5).ArcToRel(3, 3, 0, true, false, 6, 0).ClosePathRel(),
+			false,
+		},
+		{
+			"implicit repeated commands",
+			"M1,2 3,4 5 6 m1 1 2 2 L7 8 9 10",
+			NewPathData().MoveTo(1, 2).LineTo(3, 4).LineTo(5, 6).MoveToRel(1, 1).LineToRel(2, 2).LineTo(7, 8).LineTo(9, 10),
+			false,
+		},
+		{
+			"packed numbers",
+			"M1.5.5.25-1",
+			NewPathData().MoveTo(1.5, 0.5).LineTo(0.25, -1),
+			false,
+		},
+		{
+			"exponents",
+			"M1e2-2.5E-1 L1e+1,3",
+			NewPathData().MoveTo(100, -0.25).LineTo(10, 3),
+			false,
+		},
+		{
+			"arc flags without separators",
+			"M0 0a25 25 -30 0150-25",
+			NewPathData().MoveTo(0, 0).ArcToRel(25, 25, -30, false, true, 50, -25),
+			false,
+		},
+		{
+			"all commands",
+			"M0 0H1V2h3v4C1 2 3 4 5 6c1 2 3 4 5 6S1 2 3 4s1 2 3 4Q1 2 3 4q1 2 3 4T1 2t1 2A1 1 0 0 0 1 1Z",
+			NewPathData().
+				MoveTo(0, 0).H(1).V(2).HRel(3).VRel(4).
+				CurveTo(1, 2, 3, 4, 5, 6).CurveToRel(1, 2, 3, 4, 5, 6).
+				SmoothCurveTo(1, 2, 3, 4).SmoothCurveToRel(1, 2, 3, 4).
+				QuadTo(1, 2, 3, 4).QuadToRel(1, 2, 3, 4).
+				SmoothQuadTo(1, 2).SmoothQuadToRel(1, 2).
+				ArcTo(1, 1, 0, false, false, 1, 1).
+				ClosePath(),
+			false,
+		},
+		{
+			"missing moveto",
+			"L10 10",
+			PathData{},
+			true,
+		},
+		{
+			"missing parameter",
+			"M10",
+			PathData{},
+			true,
+		},
+		{
+			"invalid flag",
+			"M0 0 A1 1 0 2 0 1 1",
+			NewPathData().MoveTo(0, 0),
+			true,
+		},
+		{
+			"number after closepath",
+			"M0 0 Z 1",
+			NewPathData().MoveTo(0, 0).ClosePath(),
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePathData(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParsePathData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got.Commands) == 0 && len(tt.want.Commands) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParsePathData() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePathData_errorOffset(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want int
+	}{
+		{"missing moveto", "L10 10", 0},
+		{"invalid character", "M10 10 L20 #", 11},
+		{"invalid flag", "M0 0 A1 1 0 2 0 1 1", 12},
+		{"unexpected end", "M0 0 C1 2", 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePathData(tt.s)
+
+			var pdErr *PathDataError
+			if !errors.As(err, &pdErr) {
+				t.Errorf("ParsePathData() error = %v, want *PathDataError", err)
+				return
+			}
+			if pdErr.Offset != tt.want {
+				t.Errorf("ParsePathData() offset = %v, want %v", pdErr.Offset, tt.want)
+			}
+		})
+	}
+}
+
+func TestPathData_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    []byte
+		want    string
+		wantErr bool
+	}{
+		{
+			"round trip",
+			[]byte("M10 10l5-5a3 3 0 1 0 6 0z"),
+			"M10 10 l5 -5 a3 3 0 1 0 6 0 z",
+			false,
+		},
+		{
+			"invalid",
+			[]byte("X"),
+			"M1 1",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pd := NewPathData().MoveTo(1, 1)
+			if err := pd.UnmarshalText(tt.text); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := pd.String(); got != tt.want {
+				t.Errorf("UnmarshalText() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package svg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPathData_String(t *testing.T) {
+	tests := []struct {
+		name string
+		pd   PathData
+		want string
+	}{
+		{
+			"empty",
+			NewPathData(),
+			"",
+		},
+		{
+			"absolute commands",
+			NewPathData().
+				MoveTo(10, 10).
+				LineTo(20, 20).
+				H(30).
+				V(40).
+				CurveTo(1, 2, 3, 4, 5, 6).
+				SmoothCurveTo(1, 2, 3, 4).
+				QuadTo(1, 2, 3, 4).
+				SmoothQuadTo(1, 2).
+				ArcTo(5, 5, 30, false, true, 10, -10).
+				ClosePath(),
+			"M10 10 L20 20 H30 V40 C1 2 3 4 5 6 S1 2 3 4 Q1 2 3 4 T1 2 A5 5 30 0 1 10 -10 Z",
+		},
+		{
+			"relative commands",
+			NewPathData().
+				MoveToRel(10, 10).
+				LineToRel(20, 20).
+				HRel(30).
+				VRel(40).
+				CurveToRel(1, 2, 3, 4, 5, 6).
+				SmoothCurveToRel(1, 2, 3, 4).
+				QuadToRel(1, 2, 3, 4).
+				SmoothQuadToRel(1, 2).
+				ArcToRel(5, 5, 30, true, false, 10, -10).
+				ClosePathRel(),
+			"m10 10 l20 20 h30 v40 c1 2 3 4 5 6 s1 2 3 4 q1 2 3 4 t1 2 a5 5 30 1 0 10 -10 z",
+		},
+		{
+			"fractions",
+			NewPathData().MoveTo(0.5, -1.25),
+			"M0.5 -1.25",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pd.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPathData_branching(t *testing.T) {
+	base := NewPathData().MoveTo(0, 0).LineTo(1, 1)
+
+	a := base.LineTo(2, 2)
+	b := base.LineTo(3, 3)
+
+	if got, want := a.String(), "M0 0 L1 1 L2 2"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+	if got, want := b.String(), "M0 0 L1 1 L3 3"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+}
+
+func TestPathData_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		pd      PathData
+		want    []byte
+		wantErr bool
+	}{
+		{
+			"valid",
+			NewPathData().MoveTo(1, 2).ClosePath(),
+			[]byte("M1 2 Z"),
+			false,
+		},
+		{
+			"invalid command",
+			NewPathData(PathCommand{Type: 'X', Params: []float64{1}}),
+			nil,
+			true,
+		},
+		{
+			"invalid parameter count",
+			NewPathData(PathCommand{Type: LineToAbs, Params: []float64{1}}),
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.pd.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %v, want %v", string(got), string(tt.want))
+			}
+		})
+	}
+}
+
+func TestPathCommandType_ParamCount(t *testing.T) {
+	tests := []struct {
+		name string
+		t    PathCommandType
+		want int
+	}{
+		{"moveto", MoveToAbs, 2},
+		{"horizontal", HLineToRel, 1},
+		{"curveto", CurveToAbs, 6},
+		{"arc", ArcToRel, 7},
+		{"closepath", ClosePathAbs, 0},
+		{"invalid", PathCommandType('X'), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.ParamCount(); got != tt.want {
+				t.Errorf("ParamCount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package svg
+
+import (
+	"encoding/xml"
+	"image/color"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestNewPath(t *testing.T) {
+	d := NewPathData().MoveTo(10, 10).LineTo(20, 20)
+
+	type args struct {
+		d        *PathData
+		children []interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want Path
+	}{
+		{
+			"empty path",
+			args{},
+			Path{XMLName: xml.Name{Local: "path"}, lock: &sync.Mutex{}},
+		},
+		{
+			"simple path",
+			args{d: &d},
+			Path{XMLName: xml.Name{Local: "path"}, D: &d, lock: &sync.Mutex{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewPath(tt.args.d, tt.args.children...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPath_MarshalText(t *testing.T) {
+	red := Color{color.RGBA{255, 0, 0, 255}}
+
+	tests := []struct {
+		name      string
+		path      Path
+		wantLines []string
+		wantErr   bool
+	}{
+		{
+			"simple path",
+			P(NewPathData().MoveTo(10, 10).LineTo(20, 20)),
+			[]string{`<path d="M10 10 L20 20"></path>`},
+			false,
+		},
+		{
+			"complex path",
+			P(NewPathData().MoveTo(10, 10).HRel(5).ArcToRel(3, 3, 0, true, false, 6, 0).ClosePath()).
+				SetStroke(red).
+				SStrokeWidth(2).
+				SetFillOpacity(O(0.5)),
+			[]string{`<path d="M10 10 h5 a3 3 0 1 0 6 0 Z" stroke-width="2" stroke="#ff0000" fill-opacity="0.5"></path>`},
+			false,
+		},
+		{
+			"transformed path",
+			P(NewPathData().MoveTo(1, 2)).SetTransform(NewTransform().Translate(3, 4)),
+			[]string{`<path d="M1 2" transform="translate(3 4)"></path>`},
+			false,
+		},
+		{
+			"empty transform is left out",
+			P(NewPathData().MoveTo(1, 2)).SetTransform(NewTransform()),
+			[]string{`<path d="M1 2"></path>`},
+			false,
+		},
+		{
+			"invalid path command",
+			P(NewPathData(PathCommand{Type: 'X'})),
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := strings.Join(tt.wantLines, "")
+			gotBytes, err := xml.Marshal(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("xml.Marshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			got := string(gotBytes)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestPath_AddAttr(t *testing.T) {
+	d := NewPathData().MoveTo(1, 2)
+
+	tests := []struct {
+		name string
+		p    Path
+		want string
+	}{
+		{
+			"single attribute",
+			P(d).AddAttr("foo", "Foo"),
+			`<path d="M1 2" foo="Foo"></path>`,
+		},
+		{
+			"multiple attributes",
+			P(d).AddAttr("foo", "Foo").AddAttr("bar", "Bar"),
+			`<path d="M1 2" foo="Foo" bar="Bar"></path>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBytes, err := xml.Marshal(tt.p)
+			if err != nil {
+				t.Errorf("xml.Marshal() error = %v, wantErr %v", err, false)
+				return
+			}
+
+			got := string(gotBytes)
+			if got != tt.want {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPath_RemoveAttr(t *testing.T) {
+	d := NewPathData().MoveTo(1, 2)
+
+	tests := []struct {
+		name string
+		p    Path
+		want string
+	}{
+		{
+			"single attribute",
+			P(d).AddAttr("foo", "Foo").RemoveAttr("foo"),
+			`<path d="M1 2"></path>`,
+		},
+		{
+			"single attribute repeated",
+			P(d).AddAttr("foo", "Foo").AddAttr("bar", "Bar").AddAttr("foo", "Bar").RemoveAttr("foo"),
+			`<path d="M1 2" bar="Bar"></path>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBytes, err := xml.Marshal(tt.p)
+			if err != nil {
+				t.Errorf("xml.Marshal() error = %v, wantErr %v", err, false)
+				return
+			}
+
+			got := string(gotBytes)
+			if got != tt.want {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,358 @@
+package svg
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// WritePDF writes an SVG tag to w as a single page PDF document
+// The page has the size of the viewport of the SVG. Shapes are written as vector paths with their
+// colors, opacities, transforms and stroke styles. Containers and shapes both filled and stroked with an
+// opacity become transparency groups. Text is set in Helvetica, one of the standard fonts of PDF viewers, with
+// the default font size. Paint servers, e.g. gradients, are replaced by their fallback color if there is one.
+func WritePDF(w io.Writer, s SVG) error {
+	width, height := s.intrinsicSize()
+	if width <= 0 || height <= 0 {
+		return errors.New("svg: can not write PDF, the SVG has no size")
+	}
+
+	pc := newPDFContent()
+
+	// PDF user space is measured in points with the y axis pointing upwards
+	pc.PushTransform(Matrix{A: pointsPerPixel, D: -pointsPerPixel, F: height * pointsPerPixel})
+
+	if err := Render(s, pc, NewLengthResolver(width, height)); err != nil {
+		return err
+	}
+
+	pc.PopTransform()
+
+	return pc.writeDocument(w, width*pointsPerPixel, height*pointsPerPixel)
+}
+
+// pdfContent is the Renderer collecting the operators of a PDF content stream together with the
+// resources they use
+type pdfContent struct {
+	psBuffer
+	// states holds the fill and stroke alphas used, each of them becomes an ExtGState resource
+	states [][2]uint8
+	// forms holds the layers composited, each of them becomes a transparency group XObject resource
+	forms []pdfForm
+	// layers holds the layers being drawn, the content drawn before them is saved in them
+	layers []pdfLayer
+	// m maps the current user space into the user space of the current layer, saved holds the ones
+	// saved by PushTransform
+	m     Matrix
+	saved []Matrix
+	font  bool
+	paint PaintStyle
+}
+
+// pdfForm is the content of a layer together with the bounding box of its content in user space
+type pdfForm struct {
+	content []byte
+	bbox    BBox
+}
+
+type pdfLayer struct {
+	pdfForm
+	opacity float64
+	// m is the Matrix of the content drawn before the layer
+	m Matrix
+}
+
+func newPDFContent() *pdfContent {
+	return &pdfContent{m: IdentityMatrix()}
+}
+
+func (pc *pdfContent) FontMetrics() FontMetrics {
+	return NewHelveticaMetrics(DefaultFontSize)
+}
+
+func (pc *pdfContent) PushTransform(m Matrix) {
+	pc.saved = append(pc.saved, pc.m)
+	pc.m = pc.m.Multiply(m)
+
+	pc.op("q")
+	if m != IdentityMatrix() {
+		pc.op("cm", m.A, m.B, m.C, m.D, m.E, m.F)
+	}
+}
+
+func (pc *pdfContent) PopTransform() {
+	pc.m = pc.saved[len(pc.saved)-1]
+	pc.saved = pc.saved[:len(pc.saved)-1]
+
+	pc.op("Q")
+}
+
+// alpha selects the ExtGState setting the alpha of fill and stroke, it is skipped for opaque paint
+func (pc *pdfContent) alpha(fill, stroke *Color) {
+	state := [2]uint8{255, 255}
+	if fill != nil {
+		state[0] = fill.A
+	}
+	if stroke != nil {
+		state[1] = stroke.A
+	}
+
+	pc.extGState(state)
+}
+
+// extGState selects the ExtGState setting the fill and stroke alphas of state, it is skipped for opaque ones
+func (pc *pdfContent) extGState(state [2]uint8) {
+	if state == [2]uint8{255, 255} {
+		return
+	}
+
+	i := 0
+	for i < len(pc.states) && pc.states[i] != state {
+		i++
+	}
+	if i == len(pc.states) {
+		pc.states = append(pc.states, state)
+	}
+
+	pc.op("gs", fmt.Sprintf("/GS%d", i))
+}
+
+func (pc *pdfContent) SetPaint(ps PaintStyle) {
+	pc.paint = ps
+}
+
+func (pc *pdfContent) FillPath(pd PathData) {
+	ps := pc.paint
+	if ps.Fill == nil {
+		return
+	}
+
+	pc.op("q")
+	pc.alpha(ps.Fill, nil)
+	pc.op("rg", rgb(*ps.Fill)...)
+	pc.grow(pc.path(pd))
+
+	if ps.FillRule == FillRuleEvenOdd {
+		pc.op("f*")
+	} else {
+		pc.op("f")
+	}
+
+	pc.op("Q")
+}
+
+func (pc *pdfContent) StrokePath(pd PathData) {
+	ps := pc.paint
+	if ps.Stroke == nil {
+		return
+	}
+
+	pc.op("q")
+	pc.alpha(nil, ps.Stroke)
+	pc.op("RG", rgb(*ps.Stroke)...)
+	pc.op("w", ps.StrokeWidth)
+	pc.op("J", linecapCode(ps.Linecap))
+	pc.op("j", linejoinCode(ps.Linejoin))
+	pc.op("M", ps.Miterlimit)
+	if len(ps.Dashes) > 0 {
+		pc.op("d", "["+joinNumbers(ps.Dashes, psNumber)+"]", ps.DashOffset)
+	}
+	// miters and square caps reach the farthest beyond the path
+	d := ps.StrokeWidth / 2 * math.Max(ps.Miterlimit, math.Sqrt2)
+	pc.grow(pc.path(pd).Expand(d, d))
+	pc.op("S")
+	pc.op("Q")
+}
+
+// path writes the operators constructing a normalized PathData, it returns the bounding box of its
+// points including the control points of curves
+func (pc *pdfContent) path(pd PathData) BBox {
+	b := EmptyBBox()
+
+	forEachSegment(pd, pathSink{
+		moveTo: func(x, y float64) {
+			pc.op("m", x, y)
+			b = b.AddPoint(x, y)
+		},
+		lineTo: func(x, y float64) {
+			pc.op("l", x, y)
+			b = b.AddPoint(x, y)
+		},
+		curveTo: func(x1, y1, x2, y2, x, y float64) {
+			pc.op("c", x1, y1, x2, y2, x, y)
+			b = b.AddPoint(x1, y1).AddPoint(x2, y2).AddPoint(x, y)
+		},
+		close: func() { pc.op("h") },
+	})
+
+	return b
+}
+
+// grow adds the area of something drawn in the current user space to the bounding box of the current layer
+func (pc *pdfContent) grow(b BBox) {
+	if len(pc.layers) > 0 {
+		l := &pc.layers[len(pc.layers)-1]
+		l.bbox = l.bbox.Union(b.Transform(pc.m))
+	}
+}
+
+func (pc *pdfContent) DrawText(x, y float64, text string, anchor TextAnchor) {
+	if pc.paint.Fill == nil {
+		return
+	}
+
+	pc.font = true
+
+	fm := pc.FontMetrics()
+	advance := fm.Advance(text)
+	x = anchorStart(x, advance, anchor)
+
+	ascent, descent := fm.Extent()
+	pc.grow(BBoxOf(x, y-ascent, advance, ascent+descent))
+
+	pc.op("q")
+	pc.alpha(pc.paint.Fill, nil)
+	pc.op("rg", rgb(*pc.paint.Fill)...)
+	pc.op("BT")
+	pc.op("Tf", "/F1", DefaultFontSize)
+	// the y axis points downwards in SVG user space, so glyphs are flipped back
+	pc.op("Tm", 1.0, 0.0, 0.0, -1.0, x, y)
+	pc.op("Tj", psString(text))
+	pc.op("ET")
+	pc.op("Q")
+}
+
+func (pc *pdfContent) Clip(pd PathData, rule FillRule) {
+	pc.path(pd)
+
+	if rule == FillRuleEvenOdd {
+		pc.op("W*")
+	} else {
+		pc.op("W")
+	}
+
+	pc.op("n")
+}
+
+// PushLayer starts collecting the content of a new layer
+func (pc *pdfContent) PushLayer(opacity float64) {
+	pc.layers = append(pc.layers, pdfLayer{
+		pdfForm: pdfForm{content: append([]byte(nil), pc.Bytes()...), bbox: EmptyBBox()},
+		opacity: opacity,
+		m:       pc.m,
+	})
+	pc.m = IdentityMatrix()
+	pc.Reset()
+}
+
+// PopLayer draws the content of the current layer as a transparency group with the opacity of the layer
+func (pc *pdfContent) PopLayer() {
+	l := pc.layers[len(pc.layers)-1]
+	pc.layers = pc.layers[:len(pc.layers)-1]
+	pc.m = l.m
+
+	content := append([]byte(nil), pc.Bytes()...)
+	pc.Reset()
+	pc.Write(l.content)
+
+	if l.bbox.IsEmpty() {
+		return
+	}
+
+	pc.forms = append(pc.forms, pdfForm{content: content, bbox: l.bbox})
+	pc.grow(l.bbox)
+
+	a := uint8(math.Round(l.opacity * 255))
+
+	pc.op("q")
+	pc.extGState([2]uint8{a, a})
+	pc.op("Do", fmt.Sprintf("/Fm%d", len(pc.forms)-1))
+	pc.op("Q")
+}
+
+// writeDocument writes the complete PDF file with a single page of width x height points
+func (pc *pdfContent) writeDocument(w io.Writer, width, height float64) error {
+	content, err := deflate(pc.Bytes())
+	if err != nil {
+		return err
+	}
+
+	var resources strings.Builder
+	if len(pc.states) > 0 {
+		resources.WriteString(" /ExtGState <<")
+		for i, s := range pc.states {
+			fmt.Fprintf(&resources, " /GS%d << /ca %s /CA %s >>", i, psNumber(float64(s[0])/255), psNumber(float64(s[1])/255))
+		}
+		resources.WriteString(" >>")
+	}
+	if pc.font {
+		resources.WriteString(" /Font << /F1 << /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >> >>")
+	}
+	if len(pc.forms) > 0 {
+		// the forms follow the content stream, which is the 4th object
+		resources.WriteString(" /XObject <<")
+		for i := range pc.forms {
+			fmt.Fprintf(&resources, " /Fm%d %d 0 R", i, 5+i)
+		}
+		resources.WriteString(" >>")
+	}
+
+	var (
+		buf     bytes.Buffer
+		offsets []int
+	)
+
+	obj := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// the binary comment marks the file as binary for transfer programs
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	obj("<< /Type /Catalog /Pages 2 0 R >>")
+	obj("<< /Type /Pages /Kids [3 0 R] /Count 1 >>")
+	obj(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources <<%s >> /Contents 4 0 R >>",
+		psNumber(width), psNumber(height), resources.String()))
+	obj(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", len(content), content))
+
+	for _, f := range pc.forms {
+		fc, err := deflate(f.content)
+		if err != nil {
+			return err
+		}
+
+		obj(fmt.Sprintf("<< /Type /XObject /Subtype /Form /BBox [%s] /Group << /S /Transparency >> /Resources <<%s >> /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream",
+			joinNumbers([]float64{f.bbox.MinX, f.bbox.MinY, f.bbox.MaxX, f.bbox.MaxY}, psNumber), resources.String(), len(fc), fc))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, o := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", o)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err = w.Write(buf.Bytes())
+
+	return err
+}
+
+// deflate compresses the data of a stream for the FlateDecode filter
+func deflate(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	zw := zlib.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
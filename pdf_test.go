@@ -0,0 +1,197 @@
+package svg
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// pdfParts returns the objects of a PDF document written by WritePDF and its inflated content stream
+// It fails the test if the cross-reference table does not point to the objects.
+func pdfParts(t *testing.T, doc []byte) (string, string) {
+	t.Helper()
+
+	s := string(doc)
+	if !strings.HasPrefix(s, "%PDF-1.4\n") || !strings.HasSuffix(s, "%%EOF\n") {
+		t.Fatalf("invalid PDF header or trailer: %q", s)
+	}
+
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(s)
+	if m == nil {
+		t.Fatalf("missing startxref: %q", s)
+	}
+	xref, _ := strconv.Atoi(m[1])
+	if !strings.HasPrefix(s[xref:], "xref\n") {
+		t.Fatalf("startxref does not point to the xref table")
+	}
+
+	for i, o := range regexp.MustCompile(`(\d{10}) 00000 n`).FindAllStringSubmatch(s[xref:], -1) {
+		offset, _ := strconv.Atoi(o[1])
+		if want := fmt.Sprintf("%d 0 obj", i+1); !strings.HasPrefix(s[offset:], want) {
+			t.Errorf("xref entry %d does not point to %q", i+1, want)
+		}
+	}
+
+	start := strings.Index(s, "stream\n") + len("stream\n")
+	end := strings.Index(s, "\nendstream")
+
+	zr, err := zlib.NewReader(strings.NewReader(s[start:end]))
+	if err != nil {
+		t.Fatalf("zlib.NewReader() error = %v", err)
+	}
+
+	content, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("inflating content error = %v", err)
+	}
+
+	return s[:start], string(content)
+}
+
+func TestWritePDF(t *testing.T) {
+	red := Red.ToColor()
+
+	tests := []struct {
+		name        string
+		s           SVG
+		wantObjects []string
+		wantContent []string
+	}{
+		{
+			"page size",
+			NewSVG(100, 50),
+			[]string{"/MediaBox [0 0 75 37.5]"},
+			[]string{"q\n0.75 0 0 -0.75 0 37.5 cm\n"},
+		},
+		{
+			"page size in mm",
+			NewSVG(0, 0).SetWidth(Lth(210, Mm)).SetHeight(Lth(297, Mm)),
+			[]string{"/MediaBox [0 0 595.2756 841.8898]"},
+			nil,
+		},
+		{
+			"page size of viewBox",
+			NewSVG(0, 0).SetViewBox(VB(0, 0, 40, 20)),
+			[]string{"/MediaBox [0 0 30 15]"},
+			nil,
+		},
+		{
+			"viewBox",
+			NewSVG(100, 100, R(0, 0, 1, 1)).SetViewBox(VB(0, 0, 10, 10)),
+			nil,
+			[]string{"0.75 0 0 -0.75 0 75 cm\n", "q\n10 0 0 10 0 0 cm\n"},
+		},
+		{
+			"filled rect",
+			NewSVG(100, 50, R(1, 2, 3, 4).SetFill(red)),
+			nil,
+			[]string{"q\n1 0 0 rg\n1 2 m\n4 2 l\n4 6 l\n1 6 l\nh\nf\nQ\n"},
+		},
+		{
+			"stroke",
+			NewSVG(100, 50, L(1, 2, 3, 4).SetStroke(red).SetStrokeWidth(Lth(2)).SetStrokeLinecap(LinecapRound).SetStrokeDashArray(Lth(3), Lth(1))),
+			nil,
+			[]string{"q\n1 0 0 RG\n2 w\n1 J\n0 j\n4 M\n[3 1] 0 d\n1 2 m\n3 4 l\nS\nQ\n"},
+		},
+		{
+			"even-odd",
+			NewSVG(100, 50, Pg(Points{{0, 0}, {1, 0}, {0, 1}}).SetFillRule(FillRuleEvenOdd)),
+			nil,
+			[]string{"f*\n"},
+		},
+		{
+			"curves",
+			NewSVG(100, 50, NewPath(nil).SetD(NewPathData().MoveTo(0, 0).QuadTo(3, 3, 6, 0))),
+			nil,
+			[]string{"0 0 m\n2 2 4 2 6 0 c\n"},
+		},
+		{
+			"opacity",
+			NewSVG(100, 50, C(5, 5, 5).SetFillOpacity(O(0.5)), C(5, 5, 5).SetOpacity(0.5)),
+			[]string{"/ExtGState << /GS0 << /ca 0.502 /CA 1 >> >>"},
+			[]string{"/GS0 gs\n0 0 0 rg\n"},
+		},
+		{
+			"opacity of fill and stroke",
+			NewSVG(100, 50, R(1, 2, 3, 4).SetFill(red).SetStroke(Blue.ToColor()).SetOpacity(0.5)),
+			[]string{"/ExtGState << /GS0 << /ca 0.502 /CA 0.502 >> >>", "/XObject << /Fm0 5 0 R >>"},
+			[]string{"q\n/GS0 gs\n/Fm0 Do\nQ\n"},
+		},
+		{
+			"group opacity",
+			NewSVG(100, 50, NewGroup(R(0, 0, 1, 1).SetTransform(NewTransform().Translate(10, 20))).SetOpacity(0.5)),
+			[]string{"/ExtGState << /GS0 << /ca 0.502 /CA 0.502 >> >>"},
+			[]string{"q\n/GS0 gs\n/Fm0 Do\nQ\n"},
+		},
+		{
+			"group transform",
+			NewSVG(100, 50, NewGroup(R(0, 0, 1, 1)).SetTransform(NewTransform().Translate(10, 20))),
+			nil,
+			[]string{"q\n1 0 0 1 10 20 cm\n"},
+		},
+		{
+			"text",
+			NewSVG(100, 50, T(10, 20, CharData("Hi (there)")).SetFill(Red.ToColor())),
+			[]string{"/Font << /F1 << /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >> >>"},
+			[]string{"1 0 0 rg\nBT\n/F1 16 Tf\n1 0 0 -1 10 20 Tm\n(Hi \\(there\\)) Tj\nET\n"},
+		},
+		{
+			"text anchor",
+			NewSVG(100, 50, T(10, 20, CharData("Hi")).SetTextAnchor(Middle)),
+			nil,
+			[]string{"1 0 0 -1 2.448 20 Tm\n"},
+		},
+		{
+			"symbol viewport clip",
+			NewSVG(100, 50, NewDefs(NewSymbol("icon", C(5, 5, 10)).SetViewBox(VB(0, 0, 10, 10))), U("#icon", 1, 2).SetWidth(Lth(20)).SetHeight(Lth(20))),
+			nil,
+			[]string{"q\n1 0 0 1 1 2 cm\nq\n0 0 m\n20 0 l\n20 20 l\n0 20 l\nh\nW\nn\nq\n2 0 0 2 0 0 cm\n"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			if err := WritePDF(&buf, tt.s); err != nil {
+				t.Fatalf("WritePDF() error = %v", err)
+			}
+
+			objects, content := pdfParts(t, buf.Bytes())
+			for _, want := range tt.wantObjects {
+				if !strings.Contains(objects, want) {
+					t.Errorf("WritePDF() objects = %s, want to contain %s", objects, want)
+				}
+			}
+			for _, want := range tt.wantContent {
+				if !strings.Contains(content, want) {
+					t.Errorf("WritePDF() content = %s, want to contain %s", content, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWritePDF_layerBBox(t *testing.T) {
+	var buf bytes.Buffer
+
+	s := NewSVG(100, 50, NewGroup(R(0, 0, 1, 1).SetTransform(NewTransform().Translate(10, 20))).SetOpacity(0.5))
+	if err := WritePDF(&buf, s); err != nil {
+		t.Fatalf("WritePDF() error = %v", err)
+	}
+
+	// the bounding box of a layer is in the user space the layer was started in
+	if want := "/Subtype /Form /BBox [10 20 11 21]"; !strings.Contains(buf.String(), want) {
+		t.Errorf("WritePDF() = %s, want to contain %s", buf.String(), want)
+	}
+}
+
+func TestWritePDF_noSize(t *testing.T) {
+	if err := WritePDF(io.Discard, NewSVG(0, 0)); err == nil {
+		t.Errorf("WritePDF() error = %v, wantErr %v", err, true)
+	}
+}
@@ -0,0 +1,74 @@
+package svg
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Point represents a single coordinate pair of a Points list
+type Point struct {
+	X float64
+	Y float64
+}
+
+func (p Point) String() string {
+	return fmt.Sprintf("%v,%v", p.X, p.Y)
+}
+
+// Points represents the value of the points attribute of a Polygon or Polyline
+// See: https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/points
+type Points []Point
+
+// Pts constructs Points from a list of x and y coordinates (shortcut)
+// A trailing odd coordinate is ignored
+func Pts(coords ...float64) Points {
+	ps := make(Points, 0, len(coords)/2)
+
+	for i := 0; i+1 < len(coords); i += 2 {
+		ps = append(ps, Point{X: coords[i], Y: coords[i+1]})
+	}
+
+	return ps
+}
+
+func (ps Points) String() string {
+	s := make([]string, 0, len(ps))
+
+	for _, p := range ps {
+		s = append(s, p.String())
+	}
+
+	return strings.Join(s, " ")
+}
+
+func (ps *Points) UnmarshalText(text []byte) error {
+	p := pathDataParser{s: string(text)}
+
+	var coords []float64
+
+	p.skipWsp()
+	for !p.eof() {
+		n, err := p.number()
+		if err != nil {
+			return renameParseError("points", err)
+		}
+
+		coords = append(coords, n)
+
+		p.skipCommaWsp()
+	}
+
+	if len(coords)%2 != 0 {
+		return fmt.Errorf("odd number of coordinates in points: %d", len(coords))
+	}
+
+	*ps = Pts(coords...)
+
+	return nil
+}
+
+func (ps Points) MarshalText() ([]byte, error) {
+	s := ps.String()
+
+	return []byte(s), nil
+}
@@ -0,0 +1,116 @@
+package svg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPts(t *testing.T) {
+	tests := []struct {
+		name   string
+		coords []float64
+		want   Points
+	}{
+		{
+			"empty",
+			nil,
+			Points{},
+		},
+		{
+			"pairs",
+			[]float64{1, 2, 3.5, -4},
+			Points{{1, 2}, {3.5, -4}},
+		},
+		{
+			"odd coordinate is ignored",
+			[]float64{1, 2, 3},
+			Points{{1, 2}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Pts(tt.coords...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Pts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPoints_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		ps      Points
+		want    []byte
+		wantErr bool
+	}{
+		{
+			"empty",
+			Points{},
+			[]byte(""),
+			false,
+		},
+		{
+			"points",
+			Pts(0, 0, 10.5, -20),
+			[]byte("0,0 10.5,-20"),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.ps.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %v, want %v", string(got), string(tt.want))
+			}
+		})
+	}
+}
+
+func TestPoints_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    []byte
+		want    Points
+		wantErr bool
+	}{
+		{
+			"comma separated pairs",
+			[]byte("0,0 10.5,-20"),
+			Pts(0, 0, 10.5, -20),
+			false,
+		},
+		{
+			"whitespace and packed numbers",
+			[]byte(" 1 2\n3-4 .5.5 "),
+			Pts(1, 2, 3, -4, 0.5, 0.5),
+			false,
+		},
+		{
+			"odd number of coordinates",
+			[]byte("1,2 3"),
+			Pts(9, 9),
+			true,
+		},
+		{
+			"invalid number",
+			[]byte("1,2 x"),
+			Pts(9, 9),
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ps := Pts(9, 9)
+			if err := ps.UnmarshalText(tt.text); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(ps, tt.want) {
+				t.Errorf("UnmarshalText() got = %v, want %v", ps, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package svg
+
+import (
+	"encoding/xml"
+	"sync"
+)
+
+// Polygon represents a Polygon SVG element
+// See: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/polygon
+type Polygon struct {
+	XMLName   xml.Name
+	Points    *Points    `xml:"points,attr,omitempty"`
+	Transform *Transform `xml:"transform,attr,omitempty"`
+	Presentation
+	Attrs    []xml.Attr `xml:",attr"`
+	Children []interface{}
+	lock     *sync.Mutex
+}
+
+// Pg constructs new Polygon element (shortcut)
+func Pg(points Points, children ...interface{}) Polygon {
+	return NewPolygon(&points, children...)
+}
+
+// NewPolygon constructs new Polygon element
+func NewPolygon(points *Points, children ...interface{}) Polygon {
+	pg := Polygon{
+		XMLName: xml.Name{Local: "polygon"},
+		Points:  points,
+		lock:    &sync.Mutex{},
+	}
+
+	pg.Children = append(pg.Children, children...)
+
+	return pg
+}
+
+// SetPoints sets the points of a Polygon
+func (pg Polygon) SetPoints(points Points) Polygon {
+	pg.Points = &points
+
+	return pg
+}
+
+// UnsetPoints removes the previously set points of a Polygon
+func (pg Polygon) UnsetPoints() Polygon {
+	pg.Points = nil
+
+	return pg
+}
+
+// SetTransform sets the transform of a Polygon
+func (pg Polygon) SetTransform(transform Transform) Polygon {
+	pg.Transform = &transform
+
+	return pg
+}
+
+// UnsetTransform removes the previously set transform of a Polygon
+func (pg Polygon) UnsetTransform() Polygon {
+	pg.Transform = nil
+
+	return pg
+}
+
+// AddAttr adds a new attribute of a Polygon
+func (pg Polygon) AddAttr(name, value string) Polygon {
+	pg.lock.Lock()
+	pg.Attrs = append(pg.Attrs, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+	pg.lock.Unlock()
+
+	return pg
+}
+
+// RemoveAttr removes all attributes of a given name of a Polygon
+func (pg Polygon) RemoveAttr(name string) Polygon {
+	pg.lock.Lock()
+	var attrs []xml.Attr
+	for _, attr := range pg.Attrs {
+		if attr.Name.Local != name {
+			attrs = append(attrs, attr)
+		}
+	}
+	pg.Attrs = attrs
+	pg.lock.Unlock()
+
+	return pg
+}
+
+// BBox returns the bounding box of the fill area of a Polygon, without its transform
+// Percentages are resolved against an empty viewport, use Geometry for other viewports.
+func (pg Polygon) BBox() BBox {
+	return defaultGeometry.BBox(pg)
+}
+
+// StrokeBBox returns the bounding box of a Polygon including its stroke, without its transform
+func (pg Polygon) StrokeBBox() BBox {
+	return defaultGeometry.StrokeBBox(pg)
+}
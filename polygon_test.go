@@ -0,0 +1,141 @@
+package svg
+
+import (
+	"encoding/xml"
+	"image/color"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestPg(t *testing.T) {
+	tests := []struct {
+		name   string
+		points Points
+		want   Polygon
+	}{
+		{
+			"simple polygon",
+			Pts(0, 0, 10, 10),
+			Polygon{XMLName: xml.Name{Local: "polygon"}, Points: &Points{{0, 0}, {10, 10}}, lock: &sync.Mutex{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Pg(tt.points); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Pg() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolygon_MarshalText(t *testing.T) {
+	red := Color{color.RGBA{255, 0, 0, 255}}
+
+	tests := []struct {
+		name      string
+		polygon   Polygon
+		wantLines []string
+		wantErr   bool
+	}{
+		{
+			"empty polygon",
+			NewPolygon(nil),
+			[]string{`<polygon></polygon>`},
+			false,
+		},
+		{
+			"complex polygon",
+			Pg(Pts(0, 0, 10, 0, 10, 10)).SetStroke(red).SStrokeWidth(2).SetOpacity(0.5),
+			[]string{`<polygon points="0,0 10,0 10,10" stroke-width="2" stroke="#ff0000" opacity="0.5"></polygon>`},
+			false,
+		},
+		{
+			"transformed polygon",
+			Pg(Pts(0, 0, 1, 1)).SetTransform(NewTransform().Rotate(90)),
+			[]string{`<polygon points="0,0 1,1" transform="rotate(90)"></polygon>`},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := strings.Join(tt.wantLines, "")
+			gotBytes, err := xml.Marshal(tt.polygon)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("xml.Marshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := string(gotBytes)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestPolygon_AddAttr(t *testing.T) {
+	tests := []struct {
+		name string
+		p    Polygon
+		want string
+	}{
+		{
+			"single attribute",
+			Pg(Pts(1, 2)).AddAttr("foo", "Foo"),
+			`<polygon points="1,2" foo="Foo"></polygon>`,
+		},
+		{
+			"multiple attributes",
+			Pg(Pts(1, 2)).AddAttr("foo", "Foo").AddAttr("bar", "Bar"),
+			`<polygon points="1,2" foo="Foo" bar="Bar"></polygon>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBytes, err := xml.Marshal(tt.p)
+			if err != nil {
+				t.Errorf("xml.Marshal() error = %v, wantErr %v", err, false)
+				return
+			}
+
+			got := string(gotBytes)
+			if got != tt.want {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolygon_RemoveAttr(t *testing.T) {
+	tests := []struct {
+		name string
+		p    Polygon
+		want string
+	}{
+		{
+			"single attribute",
+			Pg(Pts(1, 2)).AddAttr("foo", "Foo").RemoveAttr("foo"),
+			`<polygon points="1,2"></polygon>`,
+		},
+		{
+			"single attribute repeated",
+			Pg(Pts(1, 2)).AddAttr("foo", "Foo").AddAttr("bar", "Bar").AddAttr("foo", "Bar").RemoveAttr("foo"),
+			`<polygon points="1,2" bar="Bar"></polygon>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBytes, err := xml.Marshal(tt.p)
+			if err != nil {
+				t.Errorf("xml.Marshal() error = %v, wantErr %v", err, false)
+				return
+			}
+
+			got := string(gotBytes)
+			if got != tt.want {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
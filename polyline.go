@@ -0,0 +1,99 @@
+package svg
+
+import (
+	"encoding/xml"
+	"sync"
+)
+
+// Polyline represents a Polyline SVG element
+// See: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/polyline
+type Polyline struct {
+	XMLName   xml.Name
+	Points    *Points    `xml:"points,attr,omitempty"`
+	Transform *Transform `xml:"transform,attr,omitempty"`
+	Presentation
+	Attrs    []xml.Attr `xml:",attr"`
+	Children []interface{}
+	lock     *sync.Mutex
+}
+
+// Pl constructs new Polyline element (shortcut)
+func Pl(points Points, children ...interface{}) Polyline {
+	return NewPolyline(&points, children...)
+}
+
+// NewPolyline constructs new Polyline element
+func NewPolyline(points *Points, children ...interface{}) Polyline {
+	pl := Polyline{
+		XMLName: xml.Name{Local: "polyline"},
+		Points:  points,
+		lock:    &sync.Mutex{},
+	}
+
+	pl.Children = append(pl.Children, children...)
+
+	return pl
+}
+
+// SetPoints sets the points of a Polyline
+func (pl Polyline) SetPoints(points Points) Polyline {
+	pl.Points = &points
+
+	return pl
+}
+
+// UnsetPoints removes the previously set points of a Polyline
+func (pl Polyline) UnsetPoints() Polyline {
+	pl.Points = nil
+
+	return pl
+}
+
+// SetTransform sets the transform of a Polyline
+func (pl Polyline) SetTransform(transform Transform) Polyline {
+	pl.Transform = &transform
+
+	return pl
+}
+
+// UnsetTransform removes the previously set transform of a Polyline
+func (pl Polyline) UnsetTransform() Polyline {
+	pl.Transform = nil
+
+	return pl
+}
+
+// AddAttr adds a new attribute of a Polyline
+func (pl Polyline) AddAttr(name, value string) Polyline {
+	pl.lock.Lock()
+	pl.Attrs = append(pl.Attrs, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+	pl.lock.Unlock()
+
+	return pl
+}
+
+// RemoveAttr removes all attributes of a given name of a Polyline
+func (pl Polyline) RemoveAttr(name string) Polyline {
+	pl.lock.Lock()
+	var attrs []xml.Attr
+	for _, attr := range pl.Attrs {
+		if attr.Name.Local != name {
+			attrs = append(attrs, attr)
+		}
+	}
+	pl.Attrs = attrs
+	pl.lock.Unlock()
+
+	return pl
+}
+
+// BBox returns the bounding box of the fill area of a Polyline, without its transform
+// Percentages are resolved against an empty viewport, use Geometry for other viewports.
+func (pl Polyline) BBox() BBox {
+	return defaultGeometry.BBox(pl)
+}
+
+// StrokeBBox returns the bounding box of a Polyline including its stroke, without its transform
+func (pl Polyline) StrokeBBox() BBox {
+	return defaultGeometry.StrokeBBox(pl)
+}
@@ -0,0 +1,141 @@
+package svg
+
+import (
+	"encoding/xml"
+	"image/color"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestPl(t *testing.T) {
+	tests := []struct {
+		name   string
+		points Points
+		want   Polyline
+	}{
+		{
+			"simple polyline",
+			Pts(0, 0, 10, 10),
+			Polyline{XMLName: xml.Name{Local: "polyline"}, Points: &Points{{0, 0}, {10, 10}}, lock: &sync.Mutex{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Pl(tt.points); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Pl() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolyline_MarshalText(t *testing.T) {
+	red := Color{color.RGBA{255, 0, 0, 255}}
+
+	tests := []struct {
+		name      string
+		polyline  Polyline
+		wantLines []string
+		wantErr   bool
+	}{
+		{
+			"empty polyline",
+			NewPolyline(nil),
+			[]string{`<polyline></polyline>`},
+			false,
+		},
+		{
+			"complex polyline",
+			Pl(Pts(0, 0, 10, 0, 10, 10)).SetStroke(red).SStrokeWidth(2).SetOpacity(0.5),
+			[]string{`<polyline points="0,0 10,0 10,10" stroke-width="2" stroke="#ff0000" opacity="0.5"></polyline>`},
+			false,
+		},
+		{
+			"transformed polyline",
+			Pl(Pts(0, 0, 1, 1)).SetTransform(NewTransform().Rotate(90)),
+			[]string{`<polyline points="0,0 1,1" transform="rotate(90)"></polyline>`},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := strings.Join(tt.wantLines, "")
+			gotBytes, err := xml.Marshal(tt.polyline)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("xml.Marshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := string(gotBytes)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestPolyline_AddAttr(t *testing.T) {
+	tests := []struct {
+		name string
+		p    Polyline
+		want string
+	}{
+		{
+			"single attribute",
+			Pl(Pts(1, 2)).AddAttr("foo", "Foo"),
+			`<polyline points="1,2" foo="Foo"></polyline>`,
+		},
+		{
+			"multiple attributes",
+			Pl(Pts(1, 2)).AddAttr("foo", "Foo").AddAttr("bar", "Bar"),
+			`<polyline points="1,2" foo="Foo" bar="Bar"></polyline>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBytes, err := xml.Marshal(tt.p)
+			if err != nil {
+				t.Errorf("xml.Marshal() error = %v, wantErr %v", err, false)
+				return
+			}
+
+			got := string(gotBytes)
+			if got != tt.want {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolyline_RemoveAttr(t *testing.T) {
+	tests := []struct {
+		name string
+		p    Polyline
+		want string
+	}{
+		{
+			"single attribute",
+			Pl(Pts(1, 2)).AddAttr("foo", "Foo").RemoveAttr("foo"),
+			`<polyline points="1,2"></polyline>`,
+		},
+		{
+			"single attribute repeated",
+			Pl(Pts(1, 2)).AddAttr("foo", "Foo").AddAttr("bar", "Bar").AddAttr("foo", "Bar").RemoveAttr("foo"),
+			`<polyline points="1,2" bar="Bar"></polyline>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBytes, err := xml.Marshal(tt.p)
+			if err != nil {
+				t.Errorf("xml.Marshal() error = %v, wantErr %v", err, false)
+				return
+			}
+
+			got := string(gotBytes)
+			if got != tt.want {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
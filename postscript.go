@@ -0,0 +1,144 @@
+package svg
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// pointsPerPixel converts CSS pixels (1/96 in) into PDF and PostScript points (1/72 in)
+const pointsPerPixel = 72.0 / 96.0
+
+// psBuffer collects the operators of a PDF content stream or a PostScript program
+type psBuffer struct {
+	bytes.Buffer
+}
+
+// op writes an operator with its operands in postfix notation, which both PDF and PostScript use
+func (b *psBuffer) op(operator string, operands ...interface{}) {
+	for _, o := range operands {
+		switch v := o.(type) {
+		case float64:
+			b.WriteString(psNumber(v))
+		default:
+			fmt.Fprint(b, v)
+		}
+		b.WriteByte(' ')
+	}
+
+	b.WriteString(operator)
+	b.WriteByte('\n')
+}
+
+// psNumber formats a number with at most 4 decimals, neither PDF nor PostScript support exponents
+func psNumber(f float64) string {
+	f = math.Round(f*10000) / 10000
+	if f == 0 {
+		// avoids -0
+		return "0"
+	}
+
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// psString returns a PDF or PostScript string literal of s, characters outside of ISO Latin-1 are replaced by ?
+// The printable characters of ISO Latin-1 are the same in the WinAnsiEncoding of PDF.
+func psString(s string) string {
+	var sb strings.Builder
+
+	sb.WriteByte('(')
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r >= ' ' && r <= '~':
+			sb.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&sb, "\\%03o", r)
+		default:
+			sb.WriteByte('?')
+		}
+	}
+	sb.WriteByte(')')
+
+	return sb.String()
+}
+
+// rgb returns the color channels of c as numbers between 0 and 1
+func rgb(c Color) []interface{} {
+	return []interface{}{float64(c.R) / 255, float64(c.G) / 255, float64(c.B) / 255}
+}
+
+func joinNumbers(ns []float64, format func(float64) string) string {
+	s := make([]string, 0, len(ns))
+	for _, n := range ns {
+		s = append(s, format(n))
+	}
+
+	return strings.Join(s, " ")
+}
+
+// linecapCode returns the number PDF and PostScript use for a line cap style
+func linecapCode(lc StrokeLinecap) int {
+	switch lc {
+	case LinecapRound:
+		return 1
+	case LinecapSquare:
+		return 2
+	}
+
+	return 0
+}
+
+// linejoinCode returns the number PDF and PostScript use for a line join style, unsupported ones are miter
+func linejoinCode(lj StrokeLinejoin) int {
+	switch lj {
+	case LinejoinRound:
+		return 1
+	case LinejoinBevel:
+		return 2
+	}
+
+	return 0
+}
+
+// pathSink receives the segments of a path, quadratic curves are converted into cubic ones
+type pathSink struct {
+	moveTo  func(x, y float64)
+	lineTo  func(x, y float64)
+	curveTo func(x1, y1, x2, y2, x, y float64)
+	close   func()
+}
+
+// forEachSegment calls the functions of sink with the segments of a normalized PathData
+func forEachSegment(pd PathData, sink pathSink) {
+	var cx, cy, sx, sy float64
+
+	for _, c := range pd.Commands {
+		p := c.Params
+
+		switch c.Type {
+		case MoveToAbs:
+			cx, cy, sx, sy = p[0], p[1], p[0], p[1]
+			sink.moveTo(cx, cy)
+		case LineToAbs:
+			cx, cy = p[0], p[1]
+			sink.lineTo(cx, cy)
+		case CurveToAbs:
+			cx, cy = p[4], p[5]
+			sink.curveTo(p[0], p[1], p[2], p[3], cx, cy)
+		case QuadToAbs:
+			// the control points of the cubic curve are 2/3 of the way from the end points to the control point
+			x1, y1 := cx+2.0/3*(p[0]-cx), cy+2.0/3*(p[1]-cy)
+			x2, y2 := p[2]+2.0/3*(p[0]-p[2]), p[3]+2.0/3*(p[1]-p[3])
+			cx, cy = p[2], p[3]
+			sink.curveTo(x1, y1, x2, y2, cx, cy)
+		case ClosePathAbs:
+			cx, cy = sx, sy
+			sink.close()
+		}
+	}
+}
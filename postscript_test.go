@@ -0,0 +1,65 @@
+package svg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPSString(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"abc", "(abc)"},
+		{`a\b(c)`, `(a\\b\(c\))`},
+		{"café", `(caf\351)`},
+		{"日本", "(??)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := psString(tt.s); got != tt.want {
+				t.Errorf("psString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPSNumber(t *testing.T) {
+	tests := []struct {
+		f    float64
+		want string
+	}{
+		{0, "0"},
+		{-0.00001, "0"},
+		{1.5, "1.5"},
+		{-2, "-2"},
+		{1.0 / 3, "0.3333"},
+		{1e9, "1000000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := psNumber(tt.f); got != tt.want {
+				t.Errorf("psNumber() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForEachSegment(t *testing.T) {
+	pd := NewPathData().MoveTo(0, 0).LineTo(3, 0).QuadTo(6, 0, 6, 3).CurveTo(6, 4, 5, 5, 4, 5).ClosePath().LineTo(0, 3)
+
+	var got []string
+	forEachSegment(pd.Normalize(), pathSink{
+		moveTo: func(x, y float64) { got = append(got, joinNumbers([]float64{x, y}, psNumber)+" m") },
+		lineTo: func(x, y float64) { got = append(got, joinNumbers([]float64{x, y}, psNumber)+" l") },
+		curveTo: func(x1, y1, x2, y2, x, y float64) {
+			got = append(got, joinNumbers([]float64{x1, y1, x2, y2, x, y}, psNumber)+" c")
+		},
+		close: func() { got = append(got, "h") },
+	})
+
+	want := []string{"0 0 m", "3 0 l", "5 0 6 1 6 3 c", "6 4 5 5 4 5 c", "h", "0 3 l"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("forEachSegment() = %v, want %v", got, want)
+	}
+}
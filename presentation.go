@@ -0,0 +1,511 @@
+package svg
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+//go:generate go run gen_presentation.go
+
+// Presentation holds the presentation and core attributes shared by all shapes, Group, Text, TSpan, Use and Symbol
+// It is embedded into the elements, so its fields are promoted and marshalled as attributes of the element.
+// The setters of the elements wrapping the ones of Presentation are generated into presentation_setters.go,
+// so an attribute added here together with its setters is available on all of them.
+// See: https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/Presentation
+type Presentation struct {
+	StrokeWidth      *Length         `xml:"stroke-width,attr,omitempty"`
+	Stroke           *Paint          `xml:"stroke,attr,omitempty"`
+	StrokeOpacity    *Opacity        `xml:"stroke-opacity,attr,omitempty"`
+	Fill             *Paint          `xml:"fill,attr,omitempty"`
+	FillOpacity      *Opacity        `xml:"fill-opacity,attr,omitempty"`
+	Opacity          *Opacity        `xml:"opacity,attr,omitempty"`
+	ID               string          `xml:"id,attr,omitempty"`
+	Class            string          `xml:"class,attr,omitempty"`
+	Style            string          `xml:"style,attr,omitempty"`
+	StrokeDashArray  *DashArray      `xml:"stroke-dasharray,attr,omitempty"`
+	StrokeDashOffset *Length         `xml:"stroke-dashoffset,attr,omitempty"`
+	StrokeLinecap    *StrokeLinecap  `xml:"stroke-linecap,attr,omitempty"`
+	StrokeLinejoin   *StrokeLinejoin `xml:"stroke-linejoin,attr,omitempty"`
+	StrokeMiterlimit *float64        `xml:"stroke-miterlimit,attr,omitempty"`
+	FillRule         *FillRule       `xml:"fill-rule,attr,omitempty"`
+	Visibility       *Visibility     `xml:"visibility,attr,omitempty"`
+	Display          *Display        `xml:"display,attr,omitempty"`
+}
+
+// SetStrokeWidth sets the stroke width of a Presentation
+func (pr Presentation) SetStrokeWidth(strokeWidth Length) Presentation {
+	pr.StrokeWidth = &strokeWidth
+
+	return pr
+}
+
+// SStrokeWidth sets the stroke width of a Presentation (shortcut)
+func (pr Presentation) SStrokeWidth(strokeWidth float64) Presentation {
+	pr.StrokeWidth = &Length{Number: strokeWidth}
+
+	return pr
+}
+
+// UnsetStrokeWidth removes the previously set stroke width of a Presentation
+func (pr Presentation) UnsetStrokeWidth() Presentation {
+	pr.StrokeWidth = nil
+
+	return pr
+}
+
+// SetStroke sets the stroke color of a Presentation
+func (pr Presentation) SetStroke(stroke Color) Presentation {
+	return pr.SetStrokePaint(ColorPaint(stroke))
+}
+
+// SetStrokePaint sets the stroke paint of a Presentation, e.g. a gradient or none
+func (pr Presentation) SetStrokePaint(stroke Paint) Presentation {
+	pr.Stroke = &stroke
+
+	return pr
+}
+
+// UnsetStroke removes the previously set stroke paint of a Presentation
+func (pr Presentation) UnsetStroke() Presentation {
+	pr.Stroke = nil
+
+	return pr
+}
+
+// SetStrokeOpacity sets the stroke opacity of a Presentation
+func (pr Presentation) SetStrokeOpacity(so Opacity) Presentation {
+	pr.StrokeOpacity = &so
+
+	return pr
+}
+
+// UnsetStrokeOpacity removes the previously set stroke opacity of a Presentation
+func (pr Presentation) UnsetStrokeOpacity() Presentation {
+	pr.StrokeOpacity = nil
+
+	return pr
+}
+
+// SetFill sets the fill color of a Presentation
+func (pr Presentation) SetFill(fill Color) Presentation {
+	return pr.SetFillPaint(ColorPaint(fill))
+}
+
+// SetFillPaint sets the fill paint of a Presentation, e.g. a gradient or none
+func (pr Presentation) SetFillPaint(fill Paint) Presentation {
+	pr.Fill = &fill
+
+	return pr
+}
+
+// UnsetFill removes the previously set fill paint of a Presentation
+func (pr Presentation) UnsetFill() Presentation {
+	pr.Fill = nil
+
+	return pr
+}
+
+// SetFillOpacity sets the fill opacity of a Presentation
+func (pr Presentation) SetFillOpacity(fo Opacity) Presentation {
+	pr.FillOpacity = &fo
+
+	return pr
+}
+
+// UnsetFillOpacity removes the previously set fill opacity of a Presentation
+func (pr Presentation) UnsetFillOpacity() Presentation {
+	pr.FillOpacity = nil
+
+	return pr
+}
+
+// SetOpacity sets the opacity of a Presentation, which is clamped to [0, 1]
+func (pr Presentation) SetOpacity(o float64) Presentation {
+	op := O(math.Max(0, math.Min(1, o)))
+	pr.Opacity = &op
+
+	return pr
+}
+
+// UnsetOpacity removes the previously set opacity of a Presentation
+func (pr Presentation) UnsetOpacity() Presentation {
+	pr.Opacity = nil
+
+	return pr
+}
+
+// SetID sets the id attribute of a Presentation
+func (pr Presentation) SetID(id string) Presentation {
+	pr.ID = id
+
+	return pr
+}
+
+// UnsetID removes the previously set id attribute of a Presentation
+func (pr Presentation) UnsetID() Presentation {
+	pr.ID = ""
+
+	return pr
+}
+
+// SetClass sets the class attribute of a Presentation
+func (pr Presentation) SetClass(class string) Presentation {
+	pr.Class = class
+
+	return pr
+}
+
+// UnsetClass removes the previously set class attribute of a Presentation
+func (pr Presentation) UnsetClass() Presentation {
+	pr.Class = ""
+
+	return pr
+}
+
+// SetStyle sets the style attribute of a Presentation
+func (pr Presentation) SetStyle(style string) Presentation {
+	pr.Style = style
+
+	return pr
+}
+
+// UnsetStyle removes the previously set style attribute of a Presentation
+func (pr Presentation) UnsetStyle() Presentation {
+	pr.Style = ""
+
+	return pr
+}
+
+// SetStrokeDashArray sets the stroke dash array of a Presentation
+func (pr Presentation) SetStrokeDashArray(dashes ...Length) Presentation {
+	da := DashArray(dashes)
+	pr.StrokeDashArray = &da
+
+	return pr
+}
+
+// UnsetStrokeDashArray removes the previously set stroke dash array of a Presentation
+func (pr Presentation) UnsetStrokeDashArray() Presentation {
+	pr.StrokeDashArray = nil
+
+	return pr
+}
+
+// SetStrokeDashOffset sets the stroke dash offset of a Presentation
+func (pr Presentation) SetStrokeDashOffset(offset Length) Presentation {
+	pr.StrokeDashOffset = &offset
+
+	return pr
+}
+
+// UnsetStrokeDashOffset removes the previously set stroke dash offset of a Presentation
+func (pr Presentation) UnsetStrokeDashOffset() Presentation {
+	pr.StrokeDashOffset = nil
+
+	return pr
+}
+
+// SetStrokeLinecap sets the stroke linecap of a Presentation
+func (pr Presentation) SetStrokeLinecap(lc StrokeLinecap) Presentation {
+	pr.StrokeLinecap = &lc
+
+	return pr
+}
+
+// UnsetStrokeLinecap removes the previously set stroke linecap of a Presentation
+func (pr Presentation) UnsetStrokeLinecap() Presentation {
+	pr.StrokeLinecap = nil
+
+	return pr
+}
+
+// SetStrokeLinejoin sets the stroke linejoin of a Presentation
+func (pr Presentation) SetStrokeLinejoin(lj StrokeLinejoin) Presentation {
+	pr.StrokeLinejoin = &lj
+
+	return pr
+}
+
+// UnsetStrokeLinejoin removes the previously set stroke linejoin of a Presentation
+func (pr Presentation) UnsetStrokeLinejoin() Presentation {
+	pr.StrokeLinejoin = nil
+
+	return pr
+}
+
+// SetStrokeMiterlimit sets the stroke miterlimit of a Presentation
+func (pr Presentation) SetStrokeMiterlimit(ml float64) Presentation {
+	pr.StrokeMiterlimit = &ml
+
+	return pr
+}
+
+// UnsetStrokeMiterlimit removes the previously set stroke miterlimit of a Presentation
+func (pr Presentation) UnsetStrokeMiterlimit() Presentation {
+	pr.StrokeMiterlimit = nil
+
+	return pr
+}
+
+// SetFillRule sets the fill rule of a Presentation
+func (pr Presentation) SetFillRule(fr FillRule) Presentation {
+	pr.FillRule = &fr
+
+	return pr
+}
+
+// UnsetFillRule removes the previously set fill rule of a Presentation
+func (pr Presentation) UnsetFillRule() Presentation {
+	pr.FillRule = nil
+
+	return pr
+}
+
+// SetVisibility sets the visibility of a Presentation
+func (pr Presentation) SetVisibility(v Visibility) Presentation {
+	pr.Visibility = &v
+
+	return pr
+}
+
+// UnsetVisibility removes the previously set visibility of a Presentation
+func (pr Presentation) UnsetVisibility() Presentation {
+	pr.Visibility = nil
+
+	return pr
+}
+
+// SetDisplay sets the display of a Presentation
+func (pr Presentation) SetDisplay(d Display) Presentation {
+	pr.Display = &d
+
+	return pr
+}
+
+// UnsetDisplay removes the previously set display of a Presentation
+func (pr Presentation) UnsetDisplay() Presentation {
+	pr.Display = nil
+
+	return pr
+}
+
+// DashArray represents the value of the stroke-dasharray attribute
+// An empty DashArray is marshalled as "none"
+type DashArray []Length
+
+func (da DashArray) String() string {
+	if len(da) == 0 {
+		return "none"
+	}
+
+	s := make([]string, 0, len(da))
+	for _, l := range da {
+		s = append(s, l.String())
+	}
+
+	return strings.Join(s, " ")
+}
+
+func (da *DashArray) UnmarshalText(text []byte) error {
+	t := strings.TrimSpace(string(text))
+
+	if t == "none" {
+		*da = DashArray{}
+
+		return nil
+	}
+
+	fields := strings.FieldsFunc(t, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+	if len(fields) == 0 {
+		return fmt.Errorf("invalid dash array: %q", t)
+	}
+
+	res := make(DashArray, 0, len(fields))
+	for _, f := range fields {
+		var l Length
+		if err := l.UnmarshalText([]byte(f)); err != nil {
+			return err
+		}
+		res = append(res, l)
+	}
+
+	*da = res
+
+	return nil
+}
+
+func (da DashArray) MarshalText() ([]byte, error) {
+	s := da.String()
+
+	return []byte(s), nil
+}
+
+type StrokeLinecap string
+
+const (
+	LinecapButt   StrokeLinecap = "butt"
+	LinecapRound  StrokeLinecap = "round"
+	LinecapSquare StrokeLinecap = "square"
+)
+
+func (lc *StrokeLinecap) UnmarshalText(text []byte) error {
+	v := StrokeLinecap(strings.ToLower(strings.TrimSpace(string(text))))
+
+	switch v {
+	default:
+		return fmt.Errorf("invalid stroke-linecap: %s", string(text))
+	case LinecapButt,
+		LinecapRound,
+		LinecapSquare:
+		*lc = v
+	}
+
+	return nil
+}
+
+func (lc StrokeLinecap) MarshalText() ([]byte, error) {
+	return []byte(lc), nil
+}
+
+type StrokeLinejoin string
+
+const (
+	LinejoinMiter     StrokeLinejoin = "miter"
+	LinejoinMiterClip StrokeLinejoin = "miter-clip"
+	LinejoinRound     StrokeLinejoin = "round"
+	LinejoinBevel     StrokeLinejoin = "bevel"
+	LinejoinArcs      StrokeLinejoin = "arcs"
+)
+
+func (lj *StrokeLinejoin) UnmarshalText(text []byte) error {
+	v := StrokeLinejoin(strings.ToLower(strings.TrimSpace(string(text))))
+
+	switch v {
+	default:
+		return fmt.Errorf("invalid stroke-linejoin: %s", string(text))
+	case LinejoinMiter,
+		LinejoinMiterClip,
+		LinejoinRound,
+		LinejoinBevel,
+		LinejoinArcs:
+		*lj = v
+	}
+
+	return nil
+}
+
+func (lj StrokeLinejoin) MarshalText() ([]byte, error) {
+	return []byte(lj), nil
+}
+
+type FillRule string
+
+const (
+	FillRuleNonZero FillRule = "nonzero"
+	FillRuleEvenOdd FillRule = "evenodd"
+)
+
+func (fr *FillRule) UnmarshalText(text []byte) error {
+	v := FillRule(strings.ToLower(strings.TrimSpace(string(text))))
+
+	switch v {
+	default:
+		return fmt.Errorf("invalid fill-rule: %s", string(text))
+	case FillRuleNonZero,
+		FillRuleEvenOdd:
+		*fr = v
+	}
+
+	return nil
+}
+
+func (fr FillRule) MarshalText() ([]byte, error) {
+	return []byte(fr), nil
+}
+
+type Visibility string
+
+const (
+	VisibilityVisible  Visibility = "visible"
+	VisibilityHidden   Visibility = "hidden"
+	VisibilityCollapse Visibility = "collapse"
+)
+
+func (vis *Visibility) UnmarshalText(text []byte) error {
+	v := Visibility(strings.ToLower(strings.TrimSpace(string(text))))
+
+	switch v {
+	default:
+		return fmt.Errorf("invalid visibility: %s", string(text))
+	case VisibilityVisible,
+		VisibilityHidden,
+		VisibilityCollapse:
+		*vis = v
+	}
+
+	return nil
+}
+
+func (v Visibility) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+type Display string
+
+const (
+	DisplayInline           Display = "inline"
+	DisplayBlock            Display = "block"
+	DisplayListItem         Display = "list-item"
+	DisplayRunIn            Display = "run-in"
+	DisplayCompact          Display = "compact"
+	DisplayMarker           Display = "marker"
+	DisplayTable            Display = "table"
+	DisplayInlineTable      Display = "inline-table"
+	DisplayTableRowGroup    Display = "table-row-group"
+	DisplayTableHeaderGroup Display = "table-header-group"
+	DisplayTableFooterGroup Display = "table-footer-group"
+	DisplayTableRow         Display = "table-row"
+	DisplayTableColumnGroup Display = "table-column-group"
+	DisplayTableColumn      Display = "table-column"
+	DisplayTableCell        Display = "table-cell"
+	DisplayTableCaption     Display = "table-caption"
+	DisplayNone             Display = "none"
+	DisplayInherit          Display = "inherit"
+)
+
+func (d *Display) UnmarshalText(text []byte) error {
+	v := Display(strings.ToLower(strings.TrimSpace(string(text))))
+
+	switch v {
+	default:
+		return fmt.Errorf("invalid display: %s", string(text))
+	case DisplayInline,
+		DisplayBlock,
+		DisplayListItem,
+		DisplayRunIn,
+		DisplayCompact,
+		DisplayMarker,
+		DisplayTable,
+		DisplayInlineTable,
+		DisplayTableRowGroup,
+		DisplayTableHeaderGroup,
+		DisplayTableFooterGroup,
+		DisplayTableRow,
+		DisplayTableColumnGroup,
+		DisplayTableColumn,
+		DisplayTableCell,
+		DisplayTableCaption,
+		DisplayNone,
+		DisplayInherit:
+		*d = v
+	}
+
+	return nil
+}
+
+func (d Display) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
@@ -0,0 +1,3195 @@
+// Code generated by gen_presentation.go; DO NOT EDIT.
+
+package svg
+
+// SetPresentation sets all presentation attributes of a Circle
+func (c Circle) SetPresentation(pr Presentation) Circle {
+	c.Presentation = pr
+
+	return c
+}
+
+// SetStrokeWidth sets the stroke width of a Circle
+func (c Circle) SetStrokeWidth(strokeWidth Length) Circle {
+	c.Presentation = c.Presentation.SetStrokeWidth(strokeWidth)
+
+	return c
+}
+
+// SStrokeWidth sets the stroke width of a Circle (shortcut)
+func (c Circle) SStrokeWidth(strokeWidth float64) Circle {
+	c.Presentation = c.Presentation.SStrokeWidth(strokeWidth)
+
+	return c
+}
+
+// UnsetStrokeWidth removes the previously set stroke width of a Circle
+func (c Circle) UnsetStrokeWidth() Circle {
+	c.Presentation = c.Presentation.UnsetStrokeWidth()
+
+	return c
+}
+
+// SetStroke sets the stroke color of a Circle
+func (c Circle) SetStroke(stroke Color) Circle {
+	c.Presentation = c.Presentation.SetStroke(stroke)
+
+	return c
+}
+
+// SetStrokePaint sets the stroke paint of a Circle, e.g. a gradient or none
+func (c Circle) SetStrokePaint(stroke Paint) Circle {
+	c.Presentation = c.Presentation.SetStrokePaint(stroke)
+
+	return c
+}
+
+// UnsetStroke removes the previously set stroke paint of a Circle
+func (c Circle) UnsetStroke() Circle {
+	c.Presentation = c.Presentation.UnsetStroke()
+
+	return c
+}
+
+// SetStrokeOpacity sets the stroke opacity of a Circle
+func (c Circle) SetStrokeOpacity(so Opacity) Circle {
+	c.Presentation = c.Presentation.SetStrokeOpacity(so)
+
+	return c
+}
+
+// UnsetStrokeOpacity removes the previously set stroke opacity of a Circle
+func (c Circle) UnsetStrokeOpacity() Circle {
+	c.Presentation = c.Presentation.UnsetStrokeOpacity()
+
+	return c
+}
+
+// SetFill sets the fill color of a Circle
+func (c Circle) SetFill(fill Color) Circle {
+	c.Presentation = c.Presentation.SetFill(fill)
+
+	return c
+}
+
+// SetFillPaint sets the fill paint of a Circle, e.g. a gradient or none
+func (c Circle) SetFillPaint(fill Paint) Circle {
+	c.Presentation = c.Presentation.SetFillPaint(fill)
+
+	return c
+}
+
+// UnsetFill removes the previously set fill paint of a Circle
+func (c Circle) UnsetFill() Circle {
+	c.Presentation = c.Presentation.UnsetFill()
+
+	return c
+}
+
+// SetFillOpacity sets the fill opacity of a Circle
+func (c Circle) SetFillOpacity(fo Opacity) Circle {
+	c.Presentation = c.Presentation.SetFillOpacity(fo)
+
+	return c
+}
+
+// UnsetFillOpacity removes the previously set fill opacity of a Circle
+func (c Circle) UnsetFillOpacity() Circle {
+	c.Presentation = c.Presentation.UnsetFillOpacity()
+
+	return c
+}
+
+// SetOpacity sets the opacity of a Circle, which is clamped to [0, 1]
+func (c Circle) SetOpacity(o float64) Circle {
+	c.Presentation = c.Presentation.SetOpacity(o)
+
+	return c
+}
+
+// UnsetOpacity removes the previously set opacity of a Circle
+func (c Circle) UnsetOpacity() Circle {
+	c.Presentation = c.Presentation.UnsetOpacity()
+
+	return c
+}
+
+// SetID sets the id attribute of a Circle
+func (c Circle) SetID(id string) Circle {
+	c.Presentation = c.Presentation.SetID(id)
+
+	return c
+}
+
+// UnsetID removes the previously set id attribute of a Circle
+func (c Circle) UnsetID() Circle {
+	c.Presentation = c.Presentation.UnsetID()
+
+	return c
+}
+
+// SetClass sets the class attribute of a Circle
+func (c Circle) SetClass(class string) Circle {
+	c.Presentation = c.Presentation.SetClass(class)
+
+	return c
+}
+
+// UnsetClass removes the previously set class attribute of a Circle
+func (c Circle) UnsetClass() Circle {
+	c.Presentation = c.Presentation.UnsetClass()
+
+	return c
+}
+
+// SetStyle sets the style attribute of a Circle
+func (c Circle) SetStyle(style string) Circle {
+	c.Presentation = c.Presentation.SetStyle(style)
+
+	return c
+}
+
+// UnsetStyle removes the previously set style attribute of a Circle
+func (c Circle) UnsetStyle() Circle {
+	c.Presentation = c.Presentation.UnsetStyle()
+
+	return c
+}
+
+// SetStrokeDashArray sets the stroke dash array of a Circle
+func (c Circle) SetStrokeDashArray(dashes ...Length) Circle {
+	c.Presentation = c.Presentation.SetStrokeDashArray(dashes...)
+
+	return c
+}
+
+// UnsetStrokeDashArray removes the previously set stroke dash array of a Circle
+func (c Circle) UnsetStrokeDashArray() Circle {
+	c.Presentation = c.Presentation.UnsetStrokeDashArray()
+
+	return c
+}
+
+// SetStrokeDashOffset sets the stroke dash offset of a Circle
+func (c Circle) SetStrokeDashOffset(offset Length) Circle {
+	c.Presentation = c.Presentation.SetStrokeDashOffset(offset)
+
+	return c
+}
+
+// UnsetStrokeDashOffset removes the previously set stroke dash offset of a Circle
+func (c Circle) UnsetStrokeDashOffset() Circle {
+	c.Presentation = c.Presentation.UnsetStrokeDashOffset()
+
+	return c
+}
+
+// SetStrokeLinecap sets the stroke linecap of a Circle
+func (c Circle) SetStrokeLinecap(lc StrokeLinecap) Circle {
+	c.Presentation = c.Presentation.SetStrokeLinecap(lc)
+
+	return c
+}
+
+// UnsetStrokeLinecap removes the previously set stroke linecap of a Circle
+func (c Circle) UnsetStrokeLinecap() Circle {
+	c.Presentation = c.Presentation.UnsetStrokeLinecap()
+
+	return c
+}
+
+// SetStrokeLinejoin sets the stroke linejoin of a Circle
+func (c Circle) SetStrokeLinejoin(lj StrokeLinejoin) Circle {
+	c.Presentation = c.Presentation.SetStrokeLinejoin(lj)
+
+	return c
+}
+
+// UnsetStrokeLinejoin removes the previously set stroke linejoin of a Circle
+func (c Circle) UnsetStrokeLinejoin() Circle {
+	c.Presentation = c.Presentation.UnsetStrokeLinejoin()
+
+	return c
+}
+
+// SetStrokeMiterlimit sets the stroke miterlimit of a Circle
+func (c Circle) SetStrokeMiterlimit(ml float64) Circle {
+	c.Presentation = c.Presentation.SetStrokeMiterlimit(ml)
+
+	return c
+}
+
+// UnsetStrokeMiterlimit removes the previously set stroke miterlimit of a Circle
+func (c Circle) UnsetStrokeMiterlimit() Circle {
+	c.Presentation = c.Presentation.UnsetStrokeMiterlimit()
+
+	return c
+}
+
+// SetFillRule sets the fill rule of a Circle
+func (c Circle) SetFillRule(fr FillRule) Circle {
+	c.Presentation = c.Presentation.SetFillRule(fr)
+
+	return c
+}
+
+// UnsetFillRule removes the previously set fill rule of a Circle
+func (c Circle) UnsetFillRule() Circle {
+	c.Presentation = c.Presentation.UnsetFillRule()
+
+	return c
+}
+
+// SetVisibility sets the visibility of a Circle
+func (c Circle) SetVisibility(v Visibility) Circle {
+	c.Presentation = c.Presentation.SetVisibility(v)
+
+	return c
+}
+
+// UnsetVisibility removes the previously set visibility of a Circle
+func (c Circle) UnsetVisibility() Circle {
+	c.Presentation = c.Presentation.UnsetVisibility()
+
+	return c
+}
+
+// SetDisplay sets the display of a Circle
+func (c Circle) SetDisplay(d Display) Circle {
+	c.Presentation = c.Presentation.SetDisplay(d)
+
+	return c
+}
+
+// UnsetDisplay removes the previously set display of a Circle
+func (c Circle) UnsetDisplay() Circle {
+	c.Presentation = c.Presentation.UnsetDisplay()
+
+	return c
+}
+
+// SetPresentation sets all presentation attributes of a Ellipse
+func (el Ellipse) SetPresentation(pr Presentation) Ellipse {
+	el.Presentation = pr
+
+	return el
+}
+
+// SetStrokeWidth sets the stroke width of a Ellipse
+func (el Ellipse) SetStrokeWidth(strokeWidth Length) Ellipse {
+	el.Presentation = el.Presentation.SetStrokeWidth(strokeWidth)
+
+	return el
+}
+
+// SStrokeWidth sets the stroke width of a Ellipse (shortcut)
+func (el Ellipse) SStrokeWidth(strokeWidth float64) Ellipse {
+	el.Presentation = el.Presentation.SStrokeWidth(strokeWidth)
+
+	return el
+}
+
+// UnsetStrokeWidth removes the previously set stroke width of a Ellipse
+func (el Ellipse) UnsetStrokeWidth() Ellipse {
+	el.Presentation = el.Presentation.UnsetStrokeWidth()
+
+	return el
+}
+
+// SetStroke sets the stroke color of a Ellipse
+func (el Ellipse) SetStroke(stroke Color) Ellipse {
+	el.Presentation = el.Presentation.SetStroke(stroke)
+
+	return el
+}
+
+// SetStrokePaint sets the stroke paint of a Ellipse, e.g. a gradient or none
+func (el Ellipse) SetStrokePaint(stroke Paint) Ellipse {
+	el.Presentation = el.Presentation.SetStrokePaint(stroke)
+
+	return el
+}
+
+// UnsetStroke removes the previously set stroke paint of a Ellipse
+func (el Ellipse) UnsetStroke() Ellipse {
+	el.Presentation = el.Presentation.UnsetStroke()
+
+	return el
+}
+
+// SetStrokeOpacity sets the stroke opacity of a Ellipse
+func (el Ellipse) SetStrokeOpacity(so Opacity) Ellipse {
+	el.Presentation = el.Presentation.SetStrokeOpacity(so)
+
+	return el
+}
+
+// UnsetStrokeOpacity removes the previously set stroke opacity of a Ellipse
+func (el Ellipse) UnsetStrokeOpacity() Ellipse {
+	el.Presentation = el.Presentation.UnsetStrokeOpacity()
+
+	return el
+}
+
+// SetFill sets the fill color of a Ellipse
+func (el Ellipse) SetFill(fill Color) Ellipse {
+	el.Presentation = el.Presentation.SetFill(fill)
+
+	return el
+}
+
+// SetFillPaint sets the fill paint of a Ellipse, e.g. a gradient or none
+func (el Ellipse) SetFillPaint(fill Paint) Ellipse {
+	el.Presentation = el.Presentation.SetFillPaint(fill)
+
+	return el
+}
+
+// UnsetFill removes the previously set fill paint of a Ellipse
+func (el Ellipse) UnsetFill() Ellipse {
+	el.Presentation = el.Presentation.UnsetFill()
+
+	return el
+}
+
+// SetFillOpacity sets the fill opacity of a Ellipse
+func (el Ellipse) SetFillOpacity(fo Opacity) Ellipse {
+	el.Presentation = el.Presentation.SetFillOpacity(fo)
+
+	return el
+}
+
+// UnsetFillOpacity removes the previously set fill opacity of a Ellipse
+func (el Ellipse) UnsetFillOpacity() Ellipse {
+	el.Presentation = el.Presentation.UnsetFillOpacity()
+
+	return el
+}
+
+// SetOpacity sets the opacity of a Ellipse, which is clamped to [0, 1]
+func (el Ellipse) SetOpacity(o float64) Ellipse {
+	el.Presentation = el.Presentation.SetOpacity(o)
+
+	return el
+}
+
+// UnsetOpacity removes the previously set opacity of a Ellipse
+func (el Ellipse) UnsetOpacity() Ellipse {
+	el.Presentation = el.Presentation.UnsetOpacity()
+
+	return el
+}
+
+// SetID sets the id attribute of a Ellipse
+func (el Ellipse) SetID(id string) Ellipse {
+	el.Presentation = el.Presentation.SetID(id)
+
+	return el
+}
+
+// UnsetID removes the previously set id attribute of a Ellipse
+func (el Ellipse) UnsetID() Ellipse {
+	el.Presentation = el.Presentation.UnsetID()
+
+	return el
+}
+
+// SetClass sets the class attribute of a Ellipse
+func (el Ellipse) SetClass(class string) Ellipse {
+	el.Presentation = el.Presentation.SetClass(class)
+
+	return el
+}
+
+// UnsetClass removes the previously set class attribute of a Ellipse
+func (el Ellipse) UnsetClass() Ellipse {
+	el.Presentation = el.Presentation.UnsetClass()
+
+	return el
+}
+
+// SetStyle sets the style attribute of a Ellipse
+func (el Ellipse) SetStyle(style string) Ellipse {
+	el.Presentation = el.Presentation.SetStyle(style)
+
+	return el
+}
+
+// UnsetStyle removes the previously set style attribute of a Ellipse
+func (el Ellipse) UnsetStyle() Ellipse {
+	el.Presentation = el.Presentation.UnsetStyle()
+
+	return el
+}
+
+// SetStrokeDashArray sets the stroke dash array of a Ellipse
+func (el Ellipse) SetStrokeDashArray(dashes ...Length) Ellipse {
+	el.Presentation = el.Presentation.SetStrokeDashArray(dashes...)
+
+	return el
+}
+
+// UnsetStrokeDashArray removes the previously set stroke dash array of a Ellipse
+func (el Ellipse) UnsetStrokeDashArray() Ellipse {
+	el.Presentation = el.Presentation.UnsetStrokeDashArray()
+
+	return el
+}
+
+// SetStrokeDashOffset sets the stroke dash offset of a Ellipse
+func (el Ellipse) SetStrokeDashOffset(offset Length) Ellipse {
+	el.Presentation = el.Presentation.SetStrokeDashOffset(offset)
+
+	return el
+}
+
+// UnsetStrokeDashOffset removes the previously set stroke dash offset of a Ellipse
+func (el Ellipse) UnsetStrokeDashOffset() Ellipse {
+	el.Presentation = el.Presentation.UnsetStrokeDashOffset()
+
+	return el
+}
+
+// SetStrokeLinecap sets the stroke linecap of a Ellipse
+func (el Ellipse) SetStrokeLinecap(lc StrokeLinecap) Ellipse {
+	el.Presentation = el.Presentation.SetStrokeLinecap(lc)
+
+	return el
+}
+
+// UnsetStrokeLinecap removes the previously set stroke linecap of a Ellipse
+func (el Ellipse) UnsetStrokeLinecap() Ellipse {
+	el.Presentation = el.Presentation.UnsetStrokeLinecap()
+
+	return el
+}
+
+// SetStrokeLinejoin sets the stroke linejoin of a Ellipse
+func (el Ellipse) SetStrokeLinejoin(lj StrokeLinejoin) Ellipse {
+	el.Presentation = el.Presentation.SetStrokeLinejoin(lj)
+
+	return el
+}
+
+// UnsetStrokeLinejoin removes the previously set stroke linejoin of a Ellipse
+func (el Ellipse) UnsetStrokeLinejoin() Ellipse {
+	el.Presentation = el.Presentation.UnsetStrokeLinejoin()
+
+	return el
+}
+
+// SetStrokeMiterlimit sets the stroke miterlimit of a Ellipse
+func (el Ellipse) SetStrokeMiterlimit(ml float64) Ellipse {
+	el.Presentation = el.Presentation.SetStrokeMiterlimit(ml)
+
+	return el
+}
+
+// UnsetStrokeMiterlimit removes the previously set stroke miterlimit of a Ellipse
+func (el Ellipse) UnsetStrokeMiterlimit() Ellipse {
+	el.Presentation = el.Presentation.UnsetStrokeMiterlimit()
+
+	return el
+}
+
+// SetFillRule sets the fill rule of a Ellipse
+func (el Ellipse) SetFillRule(fr FillRule) Ellipse {
+	el.Presentation = el.Presentation.SetFillRule(fr)
+
+	return el
+}
+
+// UnsetFillRule removes the previously set fill rule of a Ellipse
+func (el Ellipse) UnsetFillRule() Ellipse {
+	el.Presentation = el.Presentation.UnsetFillRule()
+
+	return el
+}
+
+// SetVisibility sets the visibility of a Ellipse
+func (el Ellipse) SetVisibility(v Visibility) Ellipse {
+	el.Presentation = el.Presentation.SetVisibility(v)
+
+	return el
+}
+
+// UnsetVisibility removes the previously set visibility of a Ellipse
+func (el Ellipse) UnsetVisibility() Ellipse {
+	el.Presentation = el.Presentation.UnsetVisibility()
+
+	return el
+}
+
+// SetDisplay sets the display of a Ellipse
+func (el Ellipse) SetDisplay(d Display) Ellipse {
+	el.Presentation = el.Presentation.SetDisplay(d)
+
+	return el
+}
+
+// UnsetDisplay removes the previously set display of a Ellipse
+func (el Ellipse) UnsetDisplay() Ellipse {
+	el.Presentation = el.Presentation.UnsetDisplay()
+
+	return el
+}
+
+// SetPresentation sets all presentation attributes of a Group
+func (g Group) SetPresentation(pr Presentation) Group {
+	g.Presentation = pr
+
+	return g
+}
+
+// SetStrokeWidth sets the stroke width of a Group
+func (g Group) SetStrokeWidth(strokeWidth Length) Group {
+	g.Presentation = g.Presentation.SetStrokeWidth(strokeWidth)
+
+	return g
+}
+
+// SStrokeWidth sets the stroke width of a Group (shortcut)
+func (g Group) SStrokeWidth(strokeWidth float64) Group {
+	g.Presentation = g.Presentation.SStrokeWidth(strokeWidth)
+
+	return g
+}
+
+// UnsetStrokeWidth removes the previously set stroke width of a Group
+func (g Group) UnsetStrokeWidth() Group {
+	g.Presentation = g.Presentation.UnsetStrokeWidth()
+
+	return g
+}
+
+// SetStroke sets the stroke color of a Group
+func (g Group) SetStroke(stroke Color) Group {
+	g.Presentation = g.Presentation.SetStroke(stroke)
+
+	return g
+}
+
+// SetStrokePaint sets the stroke paint of a Group, e.g. a gradient or none
+func (g Group) SetStrokePaint(stroke Paint) Group {
+	g.Presentation = g.Presentation.SetStrokePaint(stroke)
+
+	return g
+}
+
+// UnsetStroke removes the previously set stroke paint of a Group
+func (g Group) UnsetStroke() Group {
+	g.Presentation = g.Presentation.UnsetStroke()
+
+	return g
+}
+
+// SetStrokeOpacity sets the stroke opacity of a Group
+func (g Group) SetStrokeOpacity(so Opacity) Group {
+	g.Presentation = g.Presentation.SetStrokeOpacity(so)
+
+	return g
+}
+
+// UnsetStrokeOpacity removes the previously set stroke opacity of a Group
+func (g Group) UnsetStrokeOpacity() Group {
+	g.Presentation = g.Presentation.UnsetStrokeOpacity()
+
+	return g
+}
+
+// SetFill sets the fill color of a Group
+func (g Group) SetFill(fill Color) Group {
+	g.Presentation = g.Presentation.SetFill(fill)
+
+	return g
+}
+
+// SetFillPaint sets the fill paint of a Group, e.g. a gradient or none
+func (g Group) SetFillPaint(fill Paint) Group {
+	g.Presentation = g.Presentation.SetFillPaint(fill)
+
+	return g
+}
+
+// UnsetFill removes the previously set fill paint of a Group
+func (g Group) UnsetFill() Group {
+	g.Presentation = g.Presentation.UnsetFill()
+
+	return g
+}
+
+// SetFillOpacity sets the fill opacity of a Group
+func (g Group) SetFillOpacity(fo Opacity) Group {
+	g.Presentation = g.Presentation.SetFillOpacity(fo)
+
+	return g
+}
+
+// UnsetFillOpacity removes the previously set fill opacity of a Group
+func (g Group) UnsetFillOpacity() Group {
+	g.Presentation = g.Presentation.UnsetFillOpacity()
+
+	return g
+}
+
+// SetOpacity sets the opacity of a Group, which is clamped to [0, 1]
+func (g Group) SetOpacity(o float64) Group {
+	g.Presentation = g.Presentation.SetOpacity(o)
+
+	return g
+}
+
+// UnsetOpacity removes the previously set opacity of a Group
+func (g Group) UnsetOpacity() Group {
+	g.Presentation = g.Presentation.UnsetOpacity()
+
+	return g
+}
+
+// SetID sets the id attribute of a Group
+func (g Group) SetID(id string) Group {
+	g.Presentation = g.Presentation.SetID(id)
+
+	return g
+}
+
+// UnsetID removes the previously set id attribute of a Group
+func (g Group) UnsetID() Group {
+	g.Presentation = g.Presentation.UnsetID()
+
+	return g
+}
+
+// SetClass sets the class attribute of a Group
+func (g Group) SetClass(class string) Group {
+	g.Presentation = g.Presentation.SetClass(class)
+
+	return g
+}
+
+// UnsetClass removes the previously set class attribute of a Group
+func (g Group) UnsetClass() Group {
+	g.Presentation = g.Presentation.UnsetClass()
+
+	return g
+}
+
+// SetStyle sets the style attribute of a Group
+func (g Group) SetStyle(style string) Group {
+	g.Presentation = g.Presentation.SetStyle(style)
+
+	return g
+}
+
+// UnsetStyle removes the previously set style attribute of a Group
+func (g Group) UnsetStyle() Group {
+	g.Presentation = g.Presentation.UnsetStyle()
+
+	return g
+}
+
+// SetStrokeDashArray sets the stroke dash array of a Group
+func (g Group) SetStrokeDashArray(dashes ...Length) Group {
+	g.Presentation = g.Presentation.SetStrokeDashArray(dashes...)
+
+	return g
+}
+
+// UnsetStrokeDashArray removes the previously set stroke dash array of a Group
+func (g Group) UnsetStrokeDashArray() Group {
+	g.Presentation = g.Presentation.UnsetStrokeDashArray()
+
+	return g
+}
+
+// SetStrokeDashOffset sets the stroke dash offset of a Group
+func (g Group) SetStrokeDashOffset(offset Length) Group {
+	g.Presentation = g.Presentation.SetStrokeDashOffset(offset)
+
+	return g
+}
+
+// UnsetStrokeDashOffset removes the previously set stroke dash offset of a Group
+func (g Group) UnsetStrokeDashOffset() Group {
+	g.Presentation = g.Presentation.UnsetStrokeDashOffset()
+
+	return g
+}
+
+// SetStrokeLinecap sets the stroke linecap of a Group
+func (g Group) SetStrokeLinecap(lc StrokeLinecap) Group {
+	g.Presentation = g.Presentation.SetStrokeLinecap(lc)
+
+	return g
+}
+
+// UnsetStrokeLinecap removes the previously set stroke linecap of a Group
+func (g Group) UnsetStrokeLinecap() Group {
+	g.Presentation = g.Presentation.UnsetStrokeLinecap()
+
+	return g
+}
+
+// SetStrokeLinejoin sets the stroke linejoin of a Group
+func (g Group) SetStrokeLinejoin(lj StrokeLinejoin) Group {
+	g.Presentation = g.Presentation.SetStrokeLinejoin(lj)
+
+	return g
+}
+
+// UnsetStrokeLinejoin removes the previously set stroke linejoin of a Group
+func (g Group) UnsetStrokeLinejoin() Group {
+	g.Presentation = g.Presentation.UnsetStrokeLinejoin()
+
+	return g
+}
+
+// SetStrokeMiterlimit sets the stroke miterlimit of a Group
+func (g Group) SetStrokeMiterlimit(ml float64) Group {
+	g.Presentation = g.Presentation.SetStrokeMiterlimit(ml)
+
+	return g
+}
+
+// UnsetStrokeMiterlimit removes the previously set stroke miterlimit of a Group
+func (g Group) UnsetStrokeMiterlimit() Group {
+	g.Presentation = g.Presentation.UnsetStrokeMiterlimit()
+
+	return g
+}
+
+// SetFillRule sets the fill rule of a Group
+func (g Group) SetFillRule(fr FillRule) Group {
+	g.Presentation = g.Presentation.SetFillRule(fr)
+
+	return g
+}
+
+// UnsetFillRule removes the previously set fill rule of a Group
+func (g Group) UnsetFillRule() Group {
+	g.Presentation = g.Presentation.UnsetFillRule()
+
+	return g
+}
+
+// SetVisibility sets the visibility of a Group
+func (g Group) SetVisibility(v Visibility) Group {
+	g.Presentation = g.Presentation.SetVisibility(v)
+
+	return g
+}
+
+// UnsetVisibility removes the previously set visibility of a Group
+func (g Group) UnsetVisibility() Group {
+	g.Presentation = g.Presentation.UnsetVisibility()
+
+	return g
+}
+
+// SetDisplay sets the display of a Group
+func (g Group) SetDisplay(d Display) Group {
+	g.Presentation = g.Presentation.SetDisplay(d)
+
+	return g
+}
+
+// UnsetDisplay removes the previously set display of a Group
+func (g Group) UnsetDisplay() Group {
+	g.Presentation = g.Presentation.UnsetDisplay()
+
+	return g
+}
+
+// SetPresentation sets all presentation attributes of a Line
+func (l Line) SetPresentation(pr Presentation) Line {
+	l.Presentation = pr
+
+	return l
+}
+
+// SetStrokeWidth sets the stroke width of a Line
+func (l Line) SetStrokeWidth(strokeWidth Length) Line {
+	l.Presentation = l.Presentation.SetStrokeWidth(strokeWidth)
+
+	return l
+}
+
+// SStrokeWidth sets the stroke width of a Line (shortcut)
+func (l Line) SStrokeWidth(strokeWidth float64) Line {
+	l.Presentation = l.Presentation.SStrokeWidth(strokeWidth)
+
+	return l
+}
+
+// UnsetStrokeWidth removes the previously set stroke width of a Line
+func (l Line) UnsetStrokeWidth() Line {
+	l.Presentation = l.Presentation.UnsetStrokeWidth()
+
+	return l
+}
+
+// SetStroke sets the stroke color of a Line
+func (l Line) SetStroke(stroke Color) Line {
+	l.Presentation = l.Presentation.SetStroke(stroke)
+
+	return l
+}
+
+// SetStrokePaint sets the stroke paint of a Line, e.g. a gradient or none
+func (l Line) SetStrokePaint(stroke Paint) Line {
+	l.Presentation = l.Presentation.SetStrokePaint(stroke)
+
+	return l
+}
+
+// UnsetStroke removes the previously set stroke paint of a Line
+func (l Line) UnsetStroke() Line {
+	l.Presentation = l.Presentation.UnsetStroke()
+
+	return l
+}
+
+// SetStrokeOpacity sets the stroke opacity of a Line
+func (l Line) SetStrokeOpacity(so Opacity) Line {
+	l.Presentation = l.Presentation.SetStrokeOpacity(so)
+
+	return l
+}
+
+// UnsetStrokeOpacity removes the previously set stroke opacity of a Line
+func (l Line) UnsetStrokeOpacity() Line {
+	l.Presentation = l.Presentation.UnsetStrokeOpacity()
+
+	return l
+}
+
+// SetFill sets the fill color of a Line
+func (l Line) SetFill(fill Color) Line {
+	l.Presentation = l.Presentation.SetFill(fill)
+
+	return l
+}
+
+// SetFillPaint sets the fill paint of a Line, e.g. a gradient or none
+func (l Line) SetFillPaint(fill Paint) Line {
+	l.Presentation = l.Presentation.SetFillPaint(fill)
+
+	return l
+}
+
+// UnsetFill removes the previously set fill paint of a Line
+func (l Line) UnsetFill() Line {
+	l.Presentation = l.Presentation.UnsetFill()
+
+	return l
+}
+
+// SetFillOpacity sets the fill opacity of a Line
+func (l Line) SetFillOpacity(fo Opacity) Line {
+	l.Presentation = l.Presentation.SetFillOpacity(fo)
+
+	return l
+}
+
+// UnsetFillOpacity removes the previously set fill opacity of a Line
+func (l Line) UnsetFillOpacity() Line {
+	l.Presentation = l.Presentation.UnsetFillOpacity()
+
+	return l
+}
+
+// SetOpacity sets the opacity of a Line, which is clamped to [0, 1]
+func (l Line) SetOpacity(o float64) Line {
+	l.Presentation = l.Presentation.SetOpacity(o)
+
+	return l
+}
+
+// UnsetOpacity removes the previously set opacity of a Line
+func (l Line) UnsetOpacity() Line {
+	l.Presentation = l.Presentation.UnsetOpacity()
+
+	return l
+}
+
+// SetID sets the id attribute of a Line
+func (l Line) SetID(id string) Line {
+	l.Presentation = l.Presentation.SetID(id)
+
+	return l
+}
+
+// UnsetID removes the previously set id attribute of a Line
+func (l Line) UnsetID() Line {
+	l.Presentation = l.Presentation.UnsetID()
+
+	return l
+}
+
+// SetClass sets the class attribute of a Line
+func (l Line) SetClass(class string) Line {
+	l.Presentation = l.Presentation.SetClass(class)
+
+	return l
+}
+
+// UnsetClass removes the previously set class attribute of a Line
+func (l Line) UnsetClass() Line {
+	l.Presentation = l.Presentation.UnsetClass()
+
+	return l
+}
+
+// SetStyle sets the style attribute of a Line
+func (l Line) SetStyle(style string) Line {
+	l.Presentation = l.Presentation.SetStyle(style)
+
+	return l
+}
+
+// UnsetStyle removes the previously set style attribute of a Line
+func (l Line) UnsetStyle() Line {
+	l.Presentation = l.Presentation.UnsetStyle()
+
+	return l
+}
+
+// SetStrokeDashArray sets the stroke dash array of a Line
+func (l Line) SetStrokeDashArray(dashes ...Length) Line {
+	l.Presentation = l.Presentation.SetStrokeDashArray(dashes...)
+
+	return l
+}
+
+// UnsetStrokeDashArray removes the previously set stroke dash array of a Line
+func (l Line) UnsetStrokeDashArray() Line {
+	l.Presentation = l.Presentation.UnsetStrokeDashArray()
+
+	return l
+}
+
+// SetStrokeDashOffset sets the stroke dash offset of a Line
+func (l Line) SetStrokeDashOffset(offset Length) Line {
+	l.Presentation = l.Presentation.SetStrokeDashOffset(offset)
+
+	return l
+}
+
+// UnsetStrokeDashOffset removes the previously set stroke dash offset of a Line
+func (l Line) UnsetStrokeDashOffset() Line {
+	l.Presentation = l.Presentation.UnsetStrokeDashOffset()
+
+	return l
+}
+
+// SetStrokeLinecap sets the stroke linecap of a Line
+func (l Line) SetStrokeLinecap(lc StrokeLinecap) Line {
+	l.Presentation = l.Presentation.SetStrokeLinecap(lc)
+
+	return l
+}
+
+// UnsetStrokeLinecap removes the previously set stroke linecap of a Line
+func (l Line) UnsetStrokeLinecap() Line {
+	l.Presentation = l.Presentation.UnsetStrokeLinecap()
+
+	return l
+}
+
+// SetStrokeLinejoin sets the stroke linejoin of a Line
+func (l Line) SetStrokeLinejoin(lj StrokeLinejoin) Line {
+	l.Presentation = l.Presentation.SetStrokeLinejoin(lj)
+
+	return l
+}
+
+// UnsetStrokeLinejoin removes the previously set stroke linejoin of a Line
+func (l Line) UnsetStrokeLinejoin() Line {
+	l.Presentation = l.Presentation.UnsetStrokeLinejoin()
+
+	return l
+}
+
+// SetStrokeMiterlimit sets the stroke miterlimit of a Line
+func (l Line) SetStrokeMiterlimit(ml float64) Line {
+	l.Presentation = l.Presentation.SetStrokeMiterlimit(ml)
+
+	return l
+}
+
+// UnsetStrokeMiterlimit removes the previously set stroke miterlimit of a Line
+func (l Line) UnsetStrokeMiterlimit() Line {
+	l.Presentation = l.Presentation.UnsetStrokeMiterlimit()
+
+	return l
+}
+
+// SetFillRule sets the fill rule of a Line
+func (l Line) SetFillRule(fr FillRule) Line {
+	l.Presentation = l.Presentation.SetFillRule(fr)
+
+	return l
+}
+
+// UnsetFillRule removes the previously set fill rule of a Line
+func (l Line) UnsetFillRule() Line {
+	l.Presentation = l.Presentation.UnsetFillRule()
+
+	return l
+}
+
+// SetVisibility sets the visibility of a Line
+func (l Line) SetVisibility(v Visibility) Line {
+	l.Presentation = l.Presentation.SetVisibility(v)
+
+	return l
+}
+
+// UnsetVisibility removes the previously set visibility of a Line
+func (l Line) UnsetVisibility() Line {
+	l.Presentation = l.Presentation.UnsetVisibility()
+
+	return l
+}
+
+// SetDisplay sets the display of a Line
+func (l Line) SetDisplay(d Display) Line {
+	l.Presentation = l.Presentation.SetDisplay(d)
+
+	return l
+}
+
+// UnsetDisplay removes the previously set display of a Line
+func (l Line) UnsetDisplay() Line {
+	l.Presentation = l.Presentation.UnsetDisplay()
+
+	return l
+}
+
+// SetPresentation sets all presentation attributes of a Path
+func (p Path) SetPresentation(pr Presentation) Path {
+	p.Presentation = pr
+
+	return p
+}
+
+// SetStrokeWidth sets the stroke width of a Path
+func (p Path) SetStrokeWidth(strokeWidth Length) Path {
+	p.Presentation = p.Presentation.SetStrokeWidth(strokeWidth)
+
+	return p
+}
+
+// SStrokeWidth sets the stroke width of a Path (shortcut)
+func (p Path) SStrokeWidth(strokeWidth float64) Path {
+	p.Presentation = p.Presentation.SStrokeWidth(strokeWidth)
+
+	return p
+}
+
+// UnsetStrokeWidth removes the previously set stroke width of a Path
+func (p Path) UnsetStrokeWidth() Path {
+	p.Presentation = p.Presentation.UnsetStrokeWidth()
+
+	return p
+}
+
+// SetStroke sets the stroke color of a Path
+func (p Path) SetStroke(stroke Color) Path {
+	p.Presentation = p.Presentation.SetStroke(stroke)
+
+	return p
+}
+
+// SetStrokePaint sets the stroke paint of a Path, e.g. a gradient or none
+func (p Path) SetStrokePaint(stroke Paint) Path {
+	p.Presentation = p.Presentation.SetStrokePaint(stroke)
+
+	return p
+}
+
+// UnsetStroke removes the previously set stroke paint of a Path
+func (p Path) UnsetStroke() Path {
+	p.Presentation = p.Presentation.UnsetStroke()
+
+	return p
+}
+
+// SetStrokeOpacity sets the stroke opacity of a Path
+func (p Path) SetStrokeOpacity(so Opacity) Path {
+	p.Presentation = p.Presentation.SetStrokeOpacity(so)
+
+	return p
+}
+
+// UnsetStrokeOpacity removes the previously set stroke opacity of a Path
+func (p Path) UnsetStrokeOpacity() Path {
+	p.Presentation = p.Presentation.UnsetStrokeOpacity()
+
+	return p
+}
+
+// SetFill sets the fill color of a Path
+func (p Path) SetFill(fill Color) Path {
+	p.Presentation = p.Presentation.SetFill(fill)
+
+	return p
+}
+
+// SetFillPaint sets the fill paint of a Path, e.g. a gradient or none
+func (p Path) SetFillPaint(fill Paint) Path {
+	p.Presentation = p.Presentation.SetFillPaint(fill)
+
+	return p
+}
+
+// UnsetFill removes the previously set fill paint of a Path
+func (p Path) UnsetFill() Path {
+	p.Presentation = p.Presentation.UnsetFill()
+
+	return p
+}
+
+// SetFillOpacity sets the fill opacity of a Path
+func (p Path) SetFillOpacity(fo Opacity) Path {
+	p.Presentation = p.Presentation.SetFillOpacity(fo)
+
+	return p
+}
+
+// UnsetFillOpacity removes the previously set fill opacity of a Path
+func (p Path) UnsetFillOpacity() Path {
+	p.Presentation = p.Presentation.UnsetFillOpacity()
+
+	return p
+}
+
+// SetOpacity sets the opacity of a Path, which is clamped to [0, 1]
+func (p Path) SetOpacity(o float64) Path {
+	p.Presentation = p.Presentation.SetOpacity(o)
+
+	return p
+}
+
+// UnsetOpacity removes the previously set opacity of a Path
+func (p Path) UnsetOpacity() Path {
+	p.Presentation = p.Presentation.UnsetOpacity()
+
+	return p
+}
+
+// SetID sets the id attribute of a Path
+func (p Path) SetID(id string) Path {
+	p.Presentation = p.Presentation.SetID(id)
+
+	return p
+}
+
+// UnsetID removes the previously set id attribute of a Path
+func (p Path) UnsetID() Path {
+	p.Presentation = p.Presentation.UnsetID()
+
+	return p
+}
+
+// SetClass sets the class attribute of a Path
+func (p Path) SetClass(class string) Path {
+	p.Presentation = p.Presentation.SetClass(class)
+
+	return p
+}
+
+// UnsetClass removes the previously set class attribute of a Path
+func (p Path) UnsetClass() Path {
+	p.Presentation = p.Presentation.UnsetClass()
+
+	return p
+}
+
+// SetStyle sets the style attribute of a Path
+func (p Path) SetStyle(style string) Path {
+	p.Presentation = p.Presentation.SetStyle(style)
+
+	return p
+}
+
+// UnsetStyle removes the previously set style attribute of a Path
+func (p Path) UnsetStyle() Path {
+	p.Presentation = p.Presentation.UnsetStyle()
+
+	return p
+}
+
+// SetStrokeDashArray sets the stroke dash array of a Path
+func (p Path) SetStrokeDashArray(dashes ...Length) Path {
+	p.Presentation = p.Presentation.SetStrokeDashArray(dashes...)
+
+	return p
+}
+
+// UnsetStrokeDashArray removes the previously set stroke dash array of a Path
+func (p Path) UnsetStrokeDashArray() Path {
+	p.Presentation = p.Presentation.UnsetStrokeDashArray()
+
+	return p
+}
+
+// SetStrokeDashOffset sets the stroke dash offset of a Path
+func (p Path) SetStrokeDashOffset(offset Length) Path {
+	p.Presentation = p.Presentation.SetStrokeDashOffset(offset)
+
+	return p
+}
+
+// UnsetStrokeDashOffset removes the previously set stroke dash offset of a Path
+func (p Path) UnsetStrokeDashOffset() Path {
+	p.Presentation = p.Presentation.UnsetStrokeDashOffset()
+
+	return p
+}
+
+// SetStrokeLinecap sets the stroke linecap of a Path
+func (p Path) SetStrokeLinecap(lc StrokeLinecap) Path {
+	p.Presentation = p.Presentation.SetStrokeLinecap(lc)
+
+	return p
+}
+
+// UnsetStrokeLinecap removes the previously set stroke linecap of a Path
+func (p Path) UnsetStrokeLinecap() Path {
+	p.Presentation = p.Presentation.UnsetStrokeLinecap()
+
+	return p
+}
+
+// SetStrokeLinejoin sets the stroke linejoin of a Path
+func (p Path) SetStrokeLinejoin(lj StrokeLinejoin) Path {
+	p.Presentation = p.Presentation.SetStrokeLinejoin(lj)
+
+	return p
+}
+
+// UnsetStrokeLinejoin removes the previously set stroke linejoin of a Path
+func (p Path) UnsetStrokeLinejoin() Path {
+	p.Presentation = p.Presentation.UnsetStrokeLinejoin()
+
+	return p
+}
+
+// SetStrokeMiterlimit sets the stroke miterlimit of a Path
+func (p Path) SetStrokeMiterlimit(ml float64) Path {
+	p.Presentation = p.Presentation.SetStrokeMiterlimit(ml)
+
+	return p
+}
+
+// UnsetStrokeMiterlimit removes the previously set stroke miterlimit of a Path
+func (p Path) UnsetStrokeMiterlimit() Path {
+	p.Presentation = p.Presentation.UnsetStrokeMiterlimit()
+
+	return p
+}
+
+// SetFillRule sets the fill rule of a Path
+func (p Path) SetFillRule(fr FillRule) Path {
+	p.Presentation = p.Presentation.SetFillRule(fr)
+
+	return p
+}
+
+// UnsetFillRule removes the previously set fill rule of a Path
+func (p Path) UnsetFillRule() Path {
+	p.Presentation = p.Presentation.UnsetFillRule()
+
+	return p
+}
+
+// SetVisibility sets the visibility of a Path
+func (p Path) SetVisibility(v Visibility) Path {
+	p.Presentation = p.Presentation.SetVisibility(v)
+
+	return p
+}
+
+// UnsetVisibility removes the previously set visibility of a Path
+func (p Path) UnsetVisibility() Path {
+	p.Presentation = p.Presentation.UnsetVisibility()
+
+	return p
+}
+
+// SetDisplay sets the display of a Path
+func (p Path) SetDisplay(d Display) Path {
+	p.Presentation = p.Presentation.SetDisplay(d)
+
+	return p
+}
+
+// UnsetDisplay removes the previously set display of a Path
+func (p Path) UnsetDisplay() Path {
+	p.Presentation = p.Presentation.UnsetDisplay()
+
+	return p
+}
+
+// SetPresentation sets all presentation attributes of a Polygon
+func (pg Polygon) SetPresentation(pr Presentation) Polygon {
+	pg.Presentation = pr
+
+	return pg
+}
+
+// SetStrokeWidth sets the stroke width of a Polygon
+func (pg Polygon) SetStrokeWidth(strokeWidth Length) Polygon {
+	pg.Presentation = pg.Presentation.SetStrokeWidth(strokeWidth)
+
+	return pg
+}
+
+// SStrokeWidth sets the stroke width of a Polygon (shortcut)
+func (pg Polygon) SStrokeWidth(strokeWidth float64) Polygon {
+	pg.Presentation = pg.Presentation.SStrokeWidth(strokeWidth)
+
+	return pg
+}
+
+// UnsetStrokeWidth removes the previously set stroke width of a Polygon
+func (pg Polygon) UnsetStrokeWidth() Polygon {
+	pg.Presentation = pg.Presentation.UnsetStrokeWidth()
+
+	return pg
+}
+
+// SetStroke sets the stroke color of a Polygon
+func (pg Polygon) SetStroke(stroke Color) Polygon {
+	pg.Presentation = pg.Presentation.SetStroke(stroke)
+
+	return pg
+}
+
+// SetStrokePaint sets the stroke paint of a Polygon, e.g. a gradient or none
+func (pg Polygon) SetStrokePaint(stroke Paint) Polygon {
+	pg.Presentation = pg.Presentation.SetStrokePaint(stroke)
+
+	return pg
+}
+
+// UnsetStroke removes the previously set stroke paint of a Polygon
+func (pg Polygon) UnsetStroke() Polygon {
+	pg.Presentation = pg.Presentation.UnsetStroke()
+
+	return pg
+}
+
+// SetStrokeOpacity sets the stroke opacity of a Polygon
+func (pg Polygon) SetStrokeOpacity(so Opacity) Polygon {
+	pg.Presentation = pg.Presentation.SetStrokeOpacity(so)
+
+	return pg
+}
+
+// UnsetStrokeOpacity removes the previously set stroke opacity of a Polygon
+func (pg Polygon) UnsetStrokeOpacity() Polygon {
+	pg.Presentation = pg.Presentation.UnsetStrokeOpacity()
+
+	return pg
+}
+
+// SetFill sets the fill color of a Polygon
+func (pg Polygon) SetFill(fill Color) Polygon {
+	pg.Presentation = pg.Presentation.SetFill(fill)
+
+	return pg
+}
+
+// SetFillPaint sets the fill paint of a Polygon, e.g. a gradient or none
+func (pg Polygon) SetFillPaint(fill Paint) Polygon {
+	pg.Presentation = pg.Presentation.SetFillPaint(fill)
+
+	return pg
+}
+
+// UnsetFill removes the previously set fill paint of a Polygon
+func (pg Polygon) UnsetFill() Polygon {
+	pg.Presentation = pg.Presentation.UnsetFill()
+
+	return pg
+}
+
+// SetFillOpacity sets the fill opacity of a Polygon
+func (pg Polygon) SetFillOpacity(fo Opacity) Polygon {
+	pg.Presentation = pg.Presentation.SetFillOpacity(fo)
+
+	return pg
+}
+
+// UnsetFillOpacity removes the previously set fill opacity of a Polygon
+func (pg Polygon) UnsetFillOpacity() Polygon {
+	pg.Presentation = pg.Presentation.UnsetFillOpacity()
+
+	return pg
+}
+
+// SetOpacity sets the opacity of a Polygon, which is clamped to [0, 1]
+func (pg Polygon) SetOpacity(o float64) Polygon {
+	pg.Presentation = pg.Presentation.SetOpacity(o)
+
+	return pg
+}
+
+// UnsetOpacity removes the previously set opacity of a Polygon
+func (pg Polygon) UnsetOpacity() Polygon {
+	pg.Presentation = pg.Presentation.UnsetOpacity()
+
+	return pg
+}
+
+// SetID sets the id attribute of a Polygon
+func (pg Polygon) SetID(id string) Polygon {
+	pg.Presentation = pg.Presentation.SetID(id)
+
+	return pg
+}
+
+// UnsetID removes the previously set id attribute of a Polygon
+func (pg Polygon) UnsetID() Polygon {
+	pg.Presentation = pg.Presentation.UnsetID()
+
+	return pg
+}
+
+// SetClass sets the class attribute of a Polygon
+func (pg Polygon) SetClass(class string) Polygon {
+	pg.Presentation = pg.Presentation.SetClass(class)
+
+	return pg
+}
+
+// UnsetClass removes the previously set class attribute of a Polygon
+func (pg Polygon) UnsetClass() Polygon {
+	pg.Presentation = pg.Presentation.UnsetClass()
+
+	return pg
+}
+
+// SetStyle sets the style attribute of a Polygon
+func (pg Polygon) SetStyle(style string) Polygon {
+	pg.Presentation = pg.Presentation.SetStyle(style)
+
+	return pg
+}
+
+// UnsetStyle removes the previously set style attribute of a Polygon
+func (pg Polygon) UnsetStyle() Polygon {
+	pg.Presentation = pg.Presentation.UnsetStyle()
+
+	return pg
+}
+
+// SetStrokeDashArray sets the stroke dash array of a Polygon
+func (pg Polygon) SetStrokeDashArray(dashes ...Length) Polygon {
+	pg.Presentation = pg.Presentation.SetStrokeDashArray(dashes...)
+
+	return pg
+}
+
+// UnsetStrokeDashArray removes the previously set stroke dash array of a Polygon
+func (pg Polygon) UnsetStrokeDashArray() Polygon {
+	pg.Presentation = pg.Presentation.UnsetStrokeDashArray()
+
+	return pg
+}
+
+// SetStrokeDashOffset sets the stroke dash offset of a Polygon
+func (pg Polygon) SetStrokeDashOffset(offset Length) Polygon {
+	pg.Presentation = pg.Presentation.SetStrokeDashOffset(offset)
+
+	return pg
+}
+
+// UnsetStrokeDashOffset removes the previously set stroke dash offset of a Polygon
+func (pg Polygon) UnsetStrokeDashOffset() Polygon {
+	pg.Presentation = pg.Presentation.UnsetStrokeDashOffset()
+
+	return pg
+}
+
+// SetStrokeLinecap sets the stroke linecap of a Polygon
+func (pg Polygon) SetStrokeLinecap(lc StrokeLinecap) Polygon {
+	pg.Presentation = pg.Presentation.SetStrokeLinecap(lc)
+
+	return pg
+}
+
+// UnsetStrokeLinecap removes the previously set stroke linecap of a Polygon
+func (pg Polygon) UnsetStrokeLinecap() Polygon {
+	pg.Presentation = pg.Presentation.UnsetStrokeLinecap()
+
+	return pg
+}
+
+// SetStrokeLinejoin sets the stroke linejoin of a Polygon
+func (pg Polygon) SetStrokeLinejoin(lj StrokeLinejoin) Polygon {
+	pg.Presentation = pg.Presentation.SetStrokeLinejoin(lj)
+
+	return pg
+}
+
+// UnsetStrokeLinejoin removes the previously set stroke linejoin of a Polygon
+func (pg Polygon) UnsetStrokeLinejoin() Polygon {
+	pg.Presentation = pg.Presentation.UnsetStrokeLinejoin()
+
+	return pg
+}
+
+// SetStrokeMiterlimit sets the stroke miterlimit of a Polygon
+func (pg Polygon) SetStrokeMiterlimit(ml float64) Polygon {
+	pg.Presentation = pg.Presentation.SetStrokeMiterlimit(ml)
+
+	return pg
+}
+
+// UnsetStrokeMiterlimit removes the previously set stroke miterlimit of a Polygon
+func (pg Polygon) UnsetStrokeMiterlimit() Polygon {
+	pg.Presentation = pg.Presentation.UnsetStrokeMiterlimit()
+
+	return pg
+}
+
+// SetFillRule sets the fill rule of a Polygon
+func (pg Polygon) SetFillRule(fr FillRule) Polygon {
+	pg.Presentation = pg.Presentation.SetFillRule(fr)
+
+	return pg
+}
+
+// UnsetFillRule removes the previously set fill rule of a Polygon
+func (pg Polygon) UnsetFillRule() Polygon {
+	pg.Presentation = pg.Presentation.UnsetFillRule()
+
+	return pg
+}
+
+// SetVisibility sets the visibility of a Polygon
+func (pg Polygon) SetVisibility(v Visibility) Polygon {
+	pg.Presentation = pg.Presentation.SetVisibility(v)
+
+	return pg
+}
+
+// UnsetVisibility removes the previously set visibility of a Polygon
+func (pg Polygon) UnsetVisibility() Polygon {
+	pg.Presentation = pg.Presentation.UnsetVisibility()
+
+	return pg
+}
+
+// SetDisplay sets the display of a Polygon
+func (pg Polygon) SetDisplay(d Display) Polygon {
+	pg.Presentation = pg.Presentation.SetDisplay(d)
+
+	return pg
+}
+
+// UnsetDisplay removes the previously set display of a Polygon
+func (pg Polygon) UnsetDisplay() Polygon {
+	pg.Presentation = pg.Presentation.UnsetDisplay()
+
+	return pg
+}
+
+// SetPresentation sets all presentation attributes of a Polyline
+func (pl Polyline) SetPresentation(pr Presentation) Polyline {
+	pl.Presentation = pr
+
+	return pl
+}
+
+// SetStrokeWidth sets the stroke width of a Polyline
+func (pl Polyline) SetStrokeWidth(strokeWidth Length) Polyline {
+	pl.Presentation = pl.Presentation.SetStrokeWidth(strokeWidth)
+
+	return pl
+}
+
+// SStrokeWidth sets the stroke width of a Polyline (shortcut)
+func (pl Polyline) SStrokeWidth(strokeWidth float64) Polyline {
+	pl.Presentation = pl.Presentation.SStrokeWidth(strokeWidth)
+
+	return pl
+}
+
+// UnsetStrokeWidth removes the previously set stroke width of a Polyline
+func (pl Polyline) UnsetStrokeWidth() Polyline {
+	pl.Presentation = pl.Presentation.UnsetStrokeWidth()
+
+	return pl
+}
+
+// SetStroke sets the stroke color of a Polyline
+func (pl Polyline) SetStroke(stroke Color) Polyline {
+	pl.Presentation = pl.Presentation.SetStroke(stroke)
+
+	return pl
+}
+
+// SetStrokePaint sets the stroke paint of a Polyline, e.g. a gradient or none
+func (pl Polyline) SetStrokePaint(stroke Paint) Polyline {
+	pl.Presentation = pl.Presentation.SetStrokePaint(stroke)
+
+	return pl
+}
+
+// UnsetStroke removes the previously set stroke paint of a Polyline
+func (pl Polyline) UnsetStroke() Polyline {
+	pl.Presentation = pl.Presentation.UnsetStroke()
+
+	return pl
+}
+
+// SetStrokeOpacity sets the stroke opacity of a Polyline
+func (pl Polyline) SetStrokeOpacity(so Opacity) Polyline {
+	pl.Presentation = pl.Presentation.SetStrokeOpacity(so)
+
+	return pl
+}
+
+// UnsetStrokeOpacity removes the previously set stroke opacity of a Polyline
+func (pl Polyline) UnsetStrokeOpacity() Polyline {
+	pl.Presentation = pl.Presentation.UnsetStrokeOpacity()
+
+	return pl
+}
+
+// SetFill sets the fill color of a Polyline
+func (pl Polyline) SetFill(fill Color) Polyline {
+	pl.Presentation = pl.Presentation.SetFill(fill)
+
+	return pl
+}
+
+// SetFillPaint sets the fill paint of a Polyline, e.g. a gradient or none
+func (pl Polyline) SetFillPaint(fill Paint) Polyline {
+	pl.Presentation = pl.Presentation.SetFillPaint(fill)
+
+	return pl
+}
+
+// UnsetFill removes the previously set fill paint of a Polyline
+func (pl Polyline) UnsetFill() Polyline {
+	pl.Presentation = pl.Presentation.UnsetFill()
+
+	return pl
+}
+
+// SetFillOpacity sets the fill opacity of a Polyline
+func (pl Polyline) SetFillOpacity(fo Opacity) Polyline {
+	pl.Presentation = pl.Presentation.SetFillOpacity(fo)
+
+	return pl
+}
+
+// UnsetFillOpacity removes the previously set fill opacity of a Polyline
+func (pl Polyline) UnsetFillOpacity() Polyline {
+	pl.Presentation = pl.Presentation.UnsetFillOpacity()
+
+	return pl
+}
+
+// SetOpacity sets the opacity of a Polyline, which is clamped to [0, 1]
+func (pl Polyline) SetOpacity(o float64) Polyline {
+	pl.Presentation = pl.Presentation.SetOpacity(o)
+
+	return pl
+}
+
+// UnsetOpacity removes the previously set opacity of a Polyline
+func (pl Polyline) UnsetOpacity() Polyline {
+	pl.Presentation = pl.Presentation.UnsetOpacity()
+
+	return pl
+}
+
+// SetID sets the id attribute of a Polyline
+func (pl Polyline) SetID(id string) Polyline {
+	pl.Presentation = pl.Presentation.SetID(id)
+
+	return pl
+}
+
+// UnsetID removes the previously set id attribute of a Polyline
+func (pl Polyline) UnsetID() Polyline {
+	pl.Presentation = pl.Presentation.UnsetID()
+
+	return pl
+}
+
+// SetClass sets the class attribute of a Polyline
+func (pl Polyline) SetClass(class string) Polyline {
+	pl.Presentation = pl.Presentation.SetClass(class)
+
+	return pl
+}
+
+// UnsetClass removes the previously set class attribute of a Polyline
+func (pl Polyline) UnsetClass() Polyline {
+	pl.Presentation = pl.Presentation.UnsetClass()
+
+	return pl
+}
+
+// SetStyle sets the style attribute of a Polyline
+func (pl Polyline) SetStyle(style string) Polyline {
+	pl.Presentation = pl.Presentation.SetStyle(style)
+
+	return pl
+}
+
+// UnsetStyle removes the previously set style attribute of a Polyline
+func (pl Polyline) UnsetStyle() Polyline {
+	pl.Presentation = pl.Presentation.UnsetStyle()
+
+	return pl
+}
+
+// SetStrokeDashArray sets the stroke dash array of a Polyline
+func (pl Polyline) SetStrokeDashArray(dashes ...Length) Polyline {
+	pl.Presentation = pl.Presentation.SetStrokeDashArray(dashes...)
+
+	return pl
+}
+
+// UnsetStrokeDashArray removes the previously set stroke dash array of a Polyline
+func (pl Polyline) UnsetStrokeDashArray() Polyline {
+	pl.Presentation = pl.Presentation.UnsetStrokeDashArray()
+
+	return pl
+}
+
+// SetStrokeDashOffset sets the stroke dash offset of a Polyline
+func (pl Polyline) SetStrokeDashOffset(offset Length) Polyline {
+	pl.Presentation = pl.Presentation.SetStrokeDashOffset(offset)
+
+	return pl
+}
+
+// UnsetStrokeDashOffset removes the previously set stroke dash offset of a Polyline
+func (pl Polyline) UnsetStrokeDashOffset() Polyline {
+	pl.Presentation = pl.Presentation.UnsetStrokeDashOffset()
+
+	return pl
+}
+
+// SetStrokeLinecap sets the stroke linecap of a Polyline
+func (pl Polyline) SetStrokeLinecap(lc StrokeLinecap) Polyline {
+	pl.Presentation = pl.Presentation.SetStrokeLinecap(lc)
+
+	return pl
+}
+
+// UnsetStrokeLinecap removes the previously set stroke linecap of a Polyline
+func (pl Polyline) UnsetStrokeLinecap() Polyline {
+	pl.Presentation = pl.Presentation.UnsetStrokeLinecap()
+
+	return pl
+}
+
+// SetStrokeLinejoin sets the stroke linejoin of a Polyline
+func (pl Polyline) SetStrokeLinejoin(lj StrokeLinejoin) Polyline {
+	pl.Presentation = pl.Presentation.SetStrokeLinejoin(lj)
+
+	return pl
+}
+
+// UnsetStrokeLinejoin removes the previously set stroke linejoin of a Polyline
+func (pl Polyline) UnsetStrokeLinejoin() Polyline {
+	pl.Presentation = pl.Presentation.UnsetStrokeLinejoin()
+
+	return pl
+}
+
+// SetStrokeMiterlimit sets the stroke miterlimit of a Polyline
+func (pl Polyline) SetStrokeMiterlimit(ml float64) Polyline {
+	pl.Presentation = pl.Presentation.SetStrokeMiterlimit(ml)
+
+	return pl
+}
+
+// UnsetStrokeMiterlimit removes the previously set stroke miterlimit of a Polyline
+func (pl Polyline) UnsetStrokeMiterlimit() Polyline {
+	pl.Presentation = pl.Presentation.UnsetStrokeMiterlimit()
+
+	return pl
+}
+
+// SetFillRule sets the fill rule of a Polyline
+func (pl Polyline) SetFillRule(fr FillRule) Polyline {
+	pl.Presentation = pl.Presentation.SetFillRule(fr)
+
+	return pl
+}
+
+// UnsetFillRule removes the previously set fill rule of a Polyline
+func (pl Polyline) UnsetFillRule() Polyline {
+	pl.Presentation = pl.Presentation.UnsetFillRule()
+
+	return pl
+}
+
+// SetVisibility sets the visibility of a Polyline
+func (pl Polyline) SetVisibility(v Visibility) Polyline {
+	pl.Presentation = pl.Presentation.SetVisibility(v)
+
+	return pl
+}
+
+// UnsetVisibility removes the previously set visibility of a Polyline
+func (pl Polyline) UnsetVisibility() Polyline {
+	pl.Presentation = pl.Presentation.UnsetVisibility()
+
+	return pl
+}
+
+// SetDisplay sets the display of a Polyline
+func (pl Polyline) SetDisplay(d Display) Polyline {
+	pl.Presentation = pl.Presentation.SetDisplay(d)
+
+	return pl
+}
+
+// UnsetDisplay removes the previously set display of a Polyline
+func (pl Polyline) UnsetDisplay() Polyline {
+	pl.Presentation = pl.Presentation.UnsetDisplay()
+
+	return pl
+}
+
+// SetPresentation sets all presentation attributes of a Rect
+func (r Rect) SetPresentation(pr Presentation) Rect {
+	r.Presentation = pr
+
+	return r
+}
+
+// SetStrokeWidth sets the stroke width of a Rect
+func (r Rect) SetStrokeWidth(strokeWidth Length) Rect {
+	r.Presentation = r.Presentation.SetStrokeWidth(strokeWidth)
+
+	return r
+}
+
+// SStrokeWidth sets the stroke width of a Rect (shortcut)
+func (r Rect) SStrokeWidth(strokeWidth float64) Rect {
+	r.Presentation = r.Presentation.SStrokeWidth(strokeWidth)
+
+	return r
+}
+
+// UnsetStrokeWidth removes the previously set stroke width of a Rect
+func (r Rect) UnsetStrokeWidth() Rect {
+	r.Presentation = r.Presentation.UnsetStrokeWidth()
+
+	return r
+}
+
+// SetStroke sets the stroke color of a Rect
+func (r Rect) SetStroke(stroke Color) Rect {
+	r.Presentation = r.Presentation.SetStroke(stroke)
+
+	return r
+}
+
+// SetStrokePaint sets the stroke paint of a Rect, e.g. a gradient or none
+func (r Rect) SetStrokePaint(stroke Paint) Rect {
+	r.Presentation = r.Presentation.SetStrokePaint(stroke)
+
+	return r
+}
+
+// UnsetStroke removes the previously set stroke paint of a Rect
+func (r Rect) UnsetStroke() Rect {
+	r.Presentation = r.Presentation.UnsetStroke()
+
+	return r
+}
+
+// SetStrokeOpacity sets the stroke opacity of a Rect
+func (r Rect) SetStrokeOpacity(so Opacity) Rect {
+	r.Presentation = r.Presentation.SetStrokeOpacity(so)
+
+	return r
+}
+
+// UnsetStrokeOpacity removes the previously set stroke opacity of a Rect
+func (r Rect) UnsetStrokeOpacity() Rect {
+	r.Presentation = r.Presentation.UnsetStrokeOpacity()
+
+	return r
+}
+
+// SetFill sets the fill color of a Rect
+func (r Rect) SetFill(fill Color) Rect {
+	r.Presentation = r.Presentation.SetFill(fill)
+
+	return r
+}
+
+// SetFillPaint sets the fill paint of a Rect, e.g. a gradient or none
+func (r Rect) SetFillPaint(fill Paint) Rect {
+	r.Presentation = r.Presentation.SetFillPaint(fill)
+
+	return r
+}
+
+// UnsetFill removes the previously set fill paint of a Rect
+func (r Rect) UnsetFill() Rect {
+	r.Presentation = r.Presentation.UnsetFill()
+
+	return r
+}
+
+// SetFillOpacity sets the fill opacity of a Rect
+func (r Rect) SetFillOpacity(fo Opacity) Rect {
+	r.Presentation = r.Presentation.SetFillOpacity(fo)
+
+	return r
+}
+
+// UnsetFillOpacity removes the previously set fill opacity of a Rect
+func (r Rect) UnsetFillOpacity() Rect {
+	r.Presentation = r.Presentation.UnsetFillOpacity()
+
+	return r
+}
+
+// SetOpacity sets the opacity of a Rect, which is clamped to [0, 1]
+func (r Rect) SetOpacity(o float64) Rect {
+	r.Presentation = r.Presentation.SetOpacity(o)
+
+	return r
+}
+
+// UnsetOpacity removes the previously set opacity of a Rect
+func (r Rect) UnsetOpacity() Rect {
+	r.Presentation = r.Presentation.UnsetOpacity()
+
+	return r
+}
+
+// SetID sets the id attribute of a Rect
+func (r Rect) SetID(id string) Rect {
+	r.Presentation = r.Presentation.SetID(id)
+
+	return r
+}
+
+// UnsetID removes the previously set id attribute of a Rect
+func (r Rect) UnsetID() Rect {
+	r.Presentation = r.Presentation.UnsetID()
+
+	return r
+}
+
+// SetClass sets the class attribute of a Rect
+func (r Rect) SetClass(class string) Rect {
+	r.Presentation = r.Presentation.SetClass(class)
+
+	return r
+}
+
+// UnsetClass removes the previously set class attribute of a Rect
+func (r Rect) UnsetClass() Rect {
+	r.Presentation = r.Presentation.UnsetClass()
+
+	return r
+}
+
+// SetStyle sets the style attribute of a Rect
+func (r Rect) SetStyle(style string) Rect {
+	r.Presentation = r.Presentation.SetStyle(style)
+
+	return r
+}
+
+// UnsetStyle removes the previously set style attribute of a Rect
+func (r Rect) UnsetStyle() Rect {
+	r.Presentation = r.Presentation.UnsetStyle()
+
+	return r
+}
+
+// SetStrokeDashArray sets the stroke dash array of a Rect
+func (r Rect) SetStrokeDashArray(dashes ...Length) Rect {
+	r.Presentation = r.Presentation.SetStrokeDashArray(dashes...)
+
+	return r
+}
+
+// UnsetStrokeDashArray removes the previously set stroke dash array of a Rect
+func (r Rect) UnsetStrokeDashArray() Rect {
+	r.Presentation = r.Presentation.UnsetStrokeDashArray()
+
+	return r
+}
+
+// SetStrokeDashOffset sets the stroke dash offset of a Rect
+func (r Rect) SetStrokeDashOffset(offset Length) Rect {
+	r.Presentation = r.Presentation.SetStrokeDashOffset(offset)
+
+	return r
+}
+
+// UnsetStrokeDashOffset removes the previously set stroke dash offset of a Rect
+func (r Rect) UnsetStrokeDashOffset() Rect {
+	r.Presentation = r.Presentation.UnsetStrokeDashOffset()
+
+	return r
+}
+
+// SetStrokeLinecap sets the stroke linecap of a Rect
+func (r Rect) SetStrokeLinecap(lc StrokeLinecap) Rect {
+	r.Presentation = r.Presentation.SetStrokeLinecap(lc)
+
+	return r
+}
+
+// UnsetStrokeLinecap removes the previously set stroke linecap of a Rect
+func (r Rect) UnsetStrokeLinecap() Rect {
+	r.Presentation = r.Presentation.UnsetStrokeLinecap()
+
+	return r
+}
+
+// SetStrokeLinejoin sets the stroke linejoin of a Rect
+func (r Rect) SetStrokeLinejoin(lj StrokeLinejoin) Rect {
+	r.Presentation = r.Presentation.SetStrokeLinejoin(lj)
+
+	return r
+}
+
+// UnsetStrokeLinejoin removes the previously set stroke linejoin of a Rect
+func (r Rect) UnsetStrokeLinejoin() Rect {
+	r.Presentation = r.Presentation.UnsetStrokeLinejoin()
+
+	return r
+}
+
+// SetStrokeMiterlimit sets the stroke miterlimit of a Rect
+func (r Rect) SetStrokeMiterlimit(ml float64) Rect {
+	r.Presentation = r.Presentation.SetStrokeMiterlimit(ml)
+
+	return r
+}
+
+// UnsetStrokeMiterlimit removes the previously set stroke miterlimit of a Rect
+func (r Rect) UnsetStrokeMiterlimit() Rect {
+	r.Presentation = r.Presentation.UnsetStrokeMiterlimit()
+
+	return r
+}
+
+// SetFillRule sets the fill rule of a Rect
+func (r Rect) SetFillRule(fr FillRule) Rect {
+	r.Presentation = r.Presentation.SetFillRule(fr)
+
+	return r
+}
+
+// UnsetFillRule removes the previously set fill rule of a Rect
+func (r Rect) UnsetFillRule() Rect {
+	r.Presentation = r.Presentation.UnsetFillRule()
+
+	return r
+}
+
+// SetVisibility sets the visibility of a Rect
+func (r Rect) SetVisibility(v Visibility) Rect {
+	r.Presentation = r.Presentation.SetVisibility(v)
+
+	return r
+}
+
+// UnsetVisibility removes the previously set visibility of a Rect
+func (r Rect) UnsetVisibility() Rect {
+	r.Presentation = r.Presentation.UnsetVisibility()
+
+	return r
+}
+
+// SetDisplay sets the display of a Rect
+func (r Rect) SetDisplay(d Display) Rect {
+	r.Presentation = r.Presentation.SetDisplay(d)
+
+	return r
+}
+
+// UnsetDisplay removes the previously set display of a Rect
+func (r Rect) UnsetDisplay() Rect {
+	r.Presentation = r.Presentation.UnsetDisplay()
+
+	return r
+}
+
+// SetPresentation sets all presentation attributes of a Symbol
+func (s Symbol) SetPresentation(pr Presentation) Symbol {
+	s.Presentation = pr
+
+	return s
+}
+
+// SetStrokeWidth sets the stroke width of a Symbol
+func (s Symbol) SetStrokeWidth(strokeWidth Length) Symbol {
+	s.Presentation = s.Presentation.SetStrokeWidth(strokeWidth)
+
+	return s
+}
+
+// SStrokeWidth sets the stroke width of a Symbol (shortcut)
+func (s Symbol) SStrokeWidth(strokeWidth float64) Symbol {
+	s.Presentation = s.Presentation.SStrokeWidth(strokeWidth)
+
+	return s
+}
+
+// UnsetStrokeWidth removes the previously set stroke width of a Symbol
+func (s Symbol) UnsetStrokeWidth() Symbol {
+	s.Presentation = s.Presentation.UnsetStrokeWidth()
+
+	return s
+}
+
+// SetStroke sets the stroke color of a Symbol
+func (s Symbol) SetStroke(stroke Color) Symbol {
+	s.Presentation = s.Presentation.SetStroke(stroke)
+
+	return s
+}
+
+// SetStrokePaint sets the stroke paint of a Symbol, e.g. a gradient or none
+func (s Symbol) SetStrokePaint(stroke Paint) Symbol {
+	s.Presentation = s.Presentation.SetStrokePaint(stroke)
+
+	return s
+}
+
+// UnsetStroke removes the previously set stroke paint of a Symbol
+func (s Symbol) UnsetStroke() Symbol {
+	s.Presentation = s.Presentation.UnsetStroke()
+
+	return s
+}
+
+// SetStrokeOpacity sets the stroke opacity of a Symbol
+func (s Symbol) SetStrokeOpacity(so Opacity) Symbol {
+	s.Presentation = s.Presentation.SetStrokeOpacity(so)
+
+	return s
+}
+
+// UnsetStrokeOpacity removes the previously set stroke opacity of a Symbol
+func (s Symbol) UnsetStrokeOpacity() Symbol {
+	s.Presentation = s.Presentation.UnsetStrokeOpacity()
+
+	return s
+}
+
+// SetFill sets the fill color of a Symbol
+func (s Symbol) SetFill(fill Color) Symbol {
+	s.Presentation = s.Presentation.SetFill(fill)
+
+	return s
+}
+
+// SetFillPaint sets the fill paint of a Symbol, e.g. a gradient or none
+func (s Symbol) SetFillPaint(fill Paint) Symbol {
+	s.Presentation = s.Presentation.SetFillPaint(fill)
+
+	return s
+}
+
+// UnsetFill removes the previously set fill paint of a Symbol
+func (s Symbol) UnsetFill() Symbol {
+	s.Presentation = s.Presentation.UnsetFill()
+
+	return s
+}
+
+// SetFillOpacity sets the fill opacity of a Symbol
+func (s Symbol) SetFillOpacity(fo Opacity) Symbol {
+	s.Presentation = s.Presentation.SetFillOpacity(fo)
+
+	return s
+}
+
+// UnsetFillOpacity removes the previously set fill opacity of a Symbol
+func (s Symbol) UnsetFillOpacity() Symbol {
+	s.Presentation = s.Presentation.UnsetFillOpacity()
+
+	return s
+}
+
+// SetOpacity sets the opacity of a Symbol, which is clamped to [0, 1]
+func (s Symbol) SetOpacity(o float64) Symbol {
+	s.Presentation = s.Presentation.SetOpacity(o)
+
+	return s
+}
+
+// UnsetOpacity removes the previously set opacity of a Symbol
+func (s Symbol) UnsetOpacity() Symbol {
+	s.Presentation = s.Presentation.UnsetOpacity()
+
+	return s
+}
+
+// SetID sets the id attribute of a Symbol
+func (s Symbol) SetID(id string) Symbol {
+	s.Presentation = s.Presentation.SetID(id)
+
+	return s
+}
+
+// UnsetID removes the previously set id attribute of a Symbol
+func (s Symbol) UnsetID() Symbol {
+	s.Presentation = s.Presentation.UnsetID()
+
+	return s
+}
+
+// SetClass sets the class attribute of a Symbol
+func (s Symbol) SetClass(class string) Symbol {
+	s.Presentation = s.Presentation.SetClass(class)
+
+	return s
+}
+
+// UnsetClass removes the previously set class attribute of a Symbol
+func (s Symbol) UnsetClass() Symbol {
+	s.Presentation = s.Presentation.UnsetClass()
+
+	return s
+}
+
+// SetStyle sets the style attribute of a Symbol
+func (s Symbol) SetStyle(style string) Symbol {
+	s.Presentation = s.Presentation.SetStyle(style)
+
+	return s
+}
+
+// UnsetStyle removes the previously set style attribute of a Symbol
+func (s Symbol) UnsetStyle() Symbol {
+	s.Presentation = s.Presentation.UnsetStyle()
+
+	return s
+}
+
+// SetStrokeDashArray sets the stroke dash array of a Symbol
+func (s Symbol) SetStrokeDashArray(dashes ...Length) Symbol {
+	s.Presentation = s.Presentation.SetStrokeDashArray(dashes...)
+
+	return s
+}
+
+// UnsetStrokeDashArray removes the previously set stroke dash array of a Symbol
+func (s Symbol) UnsetStrokeDashArray() Symbol {
+	s.Presentation = s.Presentation.UnsetStrokeDashArray()
+
+	return s
+}
+
+// SetStrokeDashOffset sets the stroke dash offset of a Symbol
+func (s Symbol) SetStrokeDashOffset(offset Length) Symbol {
+	s.Presentation = s.Presentation.SetStrokeDashOffset(offset)
+
+	return s
+}
+
+// UnsetStrokeDashOffset removes the previously set stroke dash offset of a Symbol
+func (s Symbol) UnsetStrokeDashOffset() Symbol {
+	s.Presentation = s.Presentation.UnsetStrokeDashOffset()
+
+	return s
+}
+
+// SetStrokeLinecap sets the stroke linecap of a Symbol
+func (s Symbol) SetStrokeLinecap(lc StrokeLinecap) Symbol {
+	s.Presentation = s.Presentation.SetStrokeLinecap(lc)
+
+	return s
+}
+
+// UnsetStrokeLinecap removes the previously set stroke linecap of a Symbol
+func (s Symbol) UnsetStrokeLinecap() Symbol {
+	s.Presentation = s.Presentation.UnsetStrokeLinecap()
+
+	return s
+}
+
+// SetStrokeLinejoin sets the stroke linejoin of a Symbol
+func (s Symbol) SetStrokeLinejoin(lj StrokeLinejoin) Symbol {
+	s.Presentation = s.Presentation.SetStrokeLinejoin(lj)
+
+	return s
+}
+
+// UnsetStrokeLinejoin removes the previously set stroke linejoin of a Symbol
+func (s Symbol) UnsetStrokeLinejoin() Symbol {
+	s.Presentation = s.Presentation.UnsetStrokeLinejoin()
+
+	return s
+}
+
+// SetStrokeMiterlimit sets the stroke miterlimit of a Symbol
+func (s Symbol) SetStrokeMiterlimit(ml float64) Symbol {
+	s.Presentation = s.Presentation.SetStrokeMiterlimit(ml)
+
+	return s
+}
+
+// UnsetStrokeMiterlimit removes the previously set stroke miterlimit of a Symbol
+func (s Symbol) UnsetStrokeMiterlimit() Symbol {
+	s.Presentation = s.Presentation.UnsetStrokeMiterlimit()
+
+	return s
+}
+
+// SetFillRule sets the fill rule of a Symbol
+func (s Symbol) SetFillRule(fr FillRule) Symbol {
+	s.Presentation = s.Presentation.SetFillRule(fr)
+
+	return s
+}
+
+// UnsetFillRule removes the previously set fill rule of a Symbol
+func (s Symbol) UnsetFillRule() Symbol {
+	s.Presentation = s.Presentation.UnsetFillRule()
+
+	return s
+}
+
+// SetVisibility sets the visibility of a Symbol
+func (s Symbol) SetVisibility(v Visibility) Symbol {
+	s.Presentation = s.Presentation.SetVisibility(v)
+
+	return s
+}
+
+// UnsetVisibility removes the previously set visibility of a Symbol
+func (s Symbol) UnsetVisibility() Symbol {
+	s.Presentation = s.Presentation.UnsetVisibility()
+
+	return s
+}
+
+// SetDisplay sets the display of a Symbol
+func (s Symbol) SetDisplay(d Display) Symbol {
+	s.Presentation = s.Presentation.SetDisplay(d)
+
+	return s
+}
+
+// UnsetDisplay removes the previously set display of a Symbol
+func (s Symbol) UnsetDisplay() Symbol {
+	s.Presentation = s.Presentation.UnsetDisplay()
+
+	return s
+}
+
+// SetPresentation sets all presentation attributes of a Text
+func (t Text) SetPresentation(pr Presentation) Text {
+	t.Presentation = pr
+
+	return t
+}
+
+// SetStrokeWidth sets the stroke width of a Text
+func (t Text) SetStrokeWidth(strokeWidth Length) Text {
+	t.Presentation = t.Presentation.SetStrokeWidth(strokeWidth)
+
+	return t
+}
+
+// SStrokeWidth sets the stroke width of a Text (shortcut)
+func (t Text) SStrokeWidth(strokeWidth float64) Text {
+	t.Presentation = t.Presentation.SStrokeWidth(strokeWidth)
+
+	return t
+}
+
+// UnsetStrokeWidth removes the previously set stroke width of a Text
+func (t Text) UnsetStrokeWidth() Text {
+	t.Presentation = t.Presentation.UnsetStrokeWidth()
+
+	return t
+}
+
+// SetStroke sets the stroke color of a Text
+func (t Text) SetStroke(stroke Color) Text {
+	t.Presentation = t.Presentation.SetStroke(stroke)
+
+	return t
+}
+
+// SetStrokePaint sets the stroke paint of a Text, e.g. a gradient or none
+func (t Text) SetStrokePaint(stroke Paint) Text {
+	t.Presentation = t.Presentation.SetStrokePaint(stroke)
+
+	return t
+}
+
+// UnsetStroke removes the previously set stroke paint of a Text
+func (t Text) UnsetStroke() Text {
+	t.Presentation = t.Presentation.UnsetStroke()
+
+	return t
+}
+
+// SetStrokeOpacity sets the stroke opacity of a Text
+func (t Text) SetStrokeOpacity(so Opacity) Text {
+	t.Presentation = t.Presentation.SetStrokeOpacity(so)
+
+	return t
+}
+
+// UnsetStrokeOpacity removes the previously set stroke opacity of a Text
+func (t Text) UnsetStrokeOpacity() Text {
+	t.Presentation = t.Presentation.UnsetStrokeOpacity()
+
+	return t
+}
+
+// SetFill sets the fill color of a Text
+func (t Text) SetFill(fill Color) Text {
+	t.Presentation = t.Presentation.SetFill(fill)
+
+	return t
+}
+
+// SetFillPaint sets the fill paint of a Text, e.g. a gradient or none
+func (t Text) SetFillPaint(fill Paint) Text {
+	t.Presentation = t.Presentation.SetFillPaint(fill)
+
+	return t
+}
+
+// UnsetFill removes the previously set fill paint of a Text
+func (t Text) UnsetFill() Text {
+	t.Presentation = t.Presentation.UnsetFill()
+
+	return t
+}
+
+// SetFillOpacity sets the fill opacity of a Text
+func (t Text) SetFillOpacity(fo Opacity) Text {
+	t.Presentation = t.Presentation.SetFillOpacity(fo)
+
+	return t
+}
+
+// UnsetFillOpacity removes the previously set fill opacity of a Text
+func (t Text) UnsetFillOpacity() Text {
+	t.Presentation = t.Presentation.UnsetFillOpacity()
+
+	return t
+}
+
+// SetOpacity sets the opacity of a Text, which is clamped to [0, 1]
+func (t Text) SetOpacity(o float64) Text {
+	t.Presentation = t.Presentation.SetOpacity(o)
+
+	return t
+}
+
+// UnsetOpacity removes the previously set opacity of a Text
+func (t Text) UnsetOpacity() Text {
+	t.Presentation = t.Presentation.UnsetOpacity()
+
+	return t
+}
+
+// SetID sets the id attribute of a Text
+func (t Text) SetID(id string) Text {
+	t.Presentation = t.Presentation.SetID(id)
+
+	return t
+}
+
+// UnsetID removes the previously set id attribute of a Text
+func (t Text) UnsetID() Text {
+	t.Presentation = t.Presentation.UnsetID()
+
+	return t
+}
+
+// SetClass sets the class attribute of a Text
+func (t Text) SetClass(class string) Text {
+	t.Presentation = t.Presentation.SetClass(class)
+
+	return t
+}
+
+// UnsetClass removes the previously set class attribute of a Text
+func (t Text) UnsetClass() Text {
+	t.Presentation = t.Presentation.UnsetClass()
+
+	return t
+}
+
+// SetStyle sets the style attribute of a Text
+func (t Text) SetStyle(style string) Text {
+	t.Presentation = t.Presentation.SetStyle(style)
+
+	return t
+}
+
+// UnsetStyle removes the previously set style attribute of a Text
+func (t Text) UnsetStyle() Text {
+	t.Presentation = t.Presentation.UnsetStyle()
+
+	return t
+}
+
+// SetStrokeDashArray sets the stroke dash array of a Text
+func (t Text) SetStrokeDashArray(dashes ...Length) Text {
+	t.Presentation = t.Presentation.SetStrokeDashArray(dashes...)
+
+	return t
+}
+
+// UnsetStrokeDashArray removes the previously set stroke dash array of a Text
+func (t Text) UnsetStrokeDashArray() Text {
+	t.Presentation = t.Presentation.UnsetStrokeDashArray()
+
+	return t
+}
+
+// SetStrokeDashOffset sets the stroke dash offset of a Text
+func (t Text) SetStrokeDashOffset(offset Length) Text {
+	t.Presentation = t.Presentation.SetStrokeDashOffset(offset)
+
+	return t
+}
+
+// UnsetStrokeDashOffset removes the previously set stroke dash offset of a Text
+func (t Text) UnsetStrokeDashOffset() Text {
+	t.Presentation = t.Presentation.UnsetStrokeDashOffset()
+
+	return t
+}
+
+// SetStrokeLinecap sets the stroke linecap of a Text
+func (t Text) SetStrokeLinecap(lc StrokeLinecap) Text {
+	t.Presentation = t.Presentation.SetStrokeLinecap(lc)
+
+	return t
+}
+
+// UnsetStrokeLinecap removes the previously set stroke linecap of a Text
+func (t Text) UnsetStrokeLinecap() Text {
+	t.Presentation = t.Presentation.UnsetStrokeLinecap()
+
+	return t
+}
+
+// SetStrokeLinejoin sets the stroke linejoin of a Text
+func (t Text) SetStrokeLinejoin(lj StrokeLinejoin) Text {
+	t.Presentation = t.Presentation.SetStrokeLinejoin(lj)
+
+	return t
+}
+
+// UnsetStrokeLinejoin removes the previously set stroke linejoin of a Text
+func (t Text) UnsetStrokeLinejoin() Text {
+	t.Presentation = t.Presentation.UnsetStrokeLinejoin()
+
+	return t
+}
+
+// SetStrokeMiterlimit sets the stroke miterlimit of a Text
+func (t Text) SetStrokeMiterlimit(ml float64) Text {
+	t.Presentation = t.Presentation.SetStrokeMiterlimit(ml)
+
+	return t
+}
+
+// UnsetStrokeMiterlimit removes the previously set stroke miterlimit of a Text
+func (t Text) UnsetStrokeMiterlimit() Text {
+	t.Presentation = t.Presentation.UnsetStrokeMiterlimit()
+
+	return t
+}
+
+// SetFillRule sets the fill rule of a Text
+func (t Text) SetFillRule(fr FillRule) Text {
+	t.Presentation = t.Presentation.SetFillRule(fr)
+
+	return t
+}
+
+// UnsetFillRule removes the previously set fill rule of a Text
+func (t Text) UnsetFillRule() Text {
+	t.Presentation = t.Presentation.UnsetFillRule()
+
+	return t
+}
+
+// SetVisibility sets the visibility of a Text
+func (t Text) SetVisibility(v Visibility) Text {
+	t.Presentation = t.Presentation.SetVisibility(v)
+
+	return t
+}
+
+// UnsetVisibility removes the previously set visibility of a Text
+func (t Text) UnsetVisibility() Text {
+	t.Presentation = t.Presentation.UnsetVisibility()
+
+	return t
+}
+
+// SetDisplay sets the display of a Text
+func (t Text) SetDisplay(d Display) Text {
+	t.Presentation = t.Presentation.SetDisplay(d)
+
+	return t
+}
+
+// UnsetDisplay removes the previously set display of a Text
+func (t Text) UnsetDisplay() Text {
+	t.Presentation = t.Presentation.UnsetDisplay()
+
+	return t
+}
+
+// SetPresentation sets all presentation attributes of a TSpan
+func (ts TSpan) SetPresentation(pr Presentation) TSpan {
+	ts.Presentation = pr
+
+	return ts
+}
+
+// SetStrokeWidth sets the stroke width of a TSpan
+func (ts TSpan) SetStrokeWidth(strokeWidth Length) TSpan {
+	ts.Presentation = ts.Presentation.SetStrokeWidth(strokeWidth)
+
+	return ts
+}
+
+// SStrokeWidth sets the stroke width of a TSpan (shortcut)
+func (ts TSpan) SStrokeWidth(strokeWidth float64) TSpan {
+	ts.Presentation = ts.Presentation.SStrokeWidth(strokeWidth)
+
+	return ts
+}
+
+// UnsetStrokeWidth removes the previously set stroke width of a TSpan
+func (ts TSpan) UnsetStrokeWidth() TSpan {
+	ts.Presentation = ts.Presentation.UnsetStrokeWidth()
+
+	return ts
+}
+
+// SetStroke sets the stroke color of a TSpan
+func (ts TSpan) SetStroke(stroke Color) TSpan {
+	ts.Presentation = ts.Presentation.SetStroke(stroke)
+
+	return ts
+}
+
+// SetStrokePaint sets the stroke paint of a TSpan, e.g. a gradient or none
+func (ts TSpan) SetStrokePaint(stroke Paint) TSpan {
+	ts.Presentation = ts.Presentation.SetStrokePaint(stroke)
+
+	return ts
+}
+
+// UnsetStroke removes the previously set stroke paint of a TSpan
+func (ts TSpan) UnsetStroke() TSpan {
+	ts.Presentation = ts.Presentation.UnsetStroke()
+
+	return ts
+}
+
+// SetStrokeOpacity sets the stroke opacity of a TSpan
+func (ts TSpan) SetStrokeOpacity(so Opacity) TSpan {
+	ts.Presentation = ts.Presentation.SetStrokeOpacity(so)
+
+	return ts
+}
+
+// UnsetStrokeOpacity removes the previously set stroke opacity of a TSpan
+func (ts TSpan) UnsetStrokeOpacity() TSpan {
+	ts.Presentation = ts.Presentation.UnsetStrokeOpacity()
+
+	return ts
+}
+
+// SetFill sets the fill color of a TSpan
+func (ts TSpan) SetFill(fill Color) TSpan {
+	ts.Presentation = ts.Presentation.SetFill(fill)
+
+	return ts
+}
+
+// SetFillPaint sets the fill paint of a TSpan, e.g. a gradient or none
+func (ts TSpan) SetFillPaint(fill Paint) TSpan {
+	ts.Presentation = ts.Presentation.SetFillPaint(fill)
+
+	return ts
+}
+
+// UnsetFill removes the previously set fill paint of a TSpan
+func (ts TSpan) UnsetFill() TSpan {
+	ts.Presentation = ts.Presentation.UnsetFill()
+
+	return ts
+}
+
+// SetFillOpacity sets the fill opacity of a TSpan
+func (ts TSpan) SetFillOpacity(fo Opacity) TSpan {
+	ts.Presentation = ts.Presentation.SetFillOpacity(fo)
+
+	return ts
+}
+
+// UnsetFillOpacity removes the previously set fill opacity of a TSpan
+func (ts TSpan) UnsetFillOpacity() TSpan {
+	ts.Presentation = ts.Presentation.UnsetFillOpacity()
+
+	return ts
+}
+
+// SetOpacity sets the opacity of a TSpan, which is clamped to [0, 1]
+func (ts TSpan) SetOpacity(o float64) TSpan {
+	ts.Presentation = ts.Presentation.SetOpacity(o)
+
+	return ts
+}
+
+// UnsetOpacity removes the previously set opacity of a TSpan
+func (ts TSpan) UnsetOpacity() TSpan {
+	ts.Presentation = ts.Presentation.UnsetOpacity()
+
+	return ts
+}
+
+// SetID sets the id attribute of a TSpan
+func (ts TSpan) SetID(id string) TSpan {
+	ts.Presentation = ts.Presentation.SetID(id)
+
+	return ts
+}
+
+// UnsetID removes the previously set id attribute of a TSpan
+func (ts TSpan) UnsetID() TSpan {
+	ts.Presentation = ts.Presentation.UnsetID()
+
+	return ts
+}
+
+// SetClass sets the class attribute of a TSpan
+func (ts TSpan) SetClass(class string) TSpan {
+	ts.Presentation = ts.Presentation.SetClass(class)
+
+	return ts
+}
+
+// UnsetClass removes the previously set class attribute of a TSpan
+func (ts TSpan) UnsetClass() TSpan {
+	ts.Presentation = ts.Presentation.UnsetClass()
+
+	return ts
+}
+
+// SetStyle sets the style attribute of a TSpan
+func (ts TSpan) SetStyle(style string) TSpan {
+	ts.Presentation = ts.Presentation.SetStyle(style)
+
+	return ts
+}
+
+// UnsetStyle removes the previously set style attribute of a TSpan
+func (ts TSpan) UnsetStyle() TSpan {
+	ts.Presentation = ts.Presentation.UnsetStyle()
+
+	return ts
+}
+
+// SetStrokeDashArray sets the stroke dash array of a TSpan
+func (ts TSpan) SetStrokeDashArray(dashes ...Length) TSpan {
+	ts.Presentation = ts.Presentation.SetStrokeDashArray(dashes...)
+
+	return ts
+}
+
+// UnsetStrokeDashArray removes the previously set stroke dash array of a TSpan
+func (ts TSpan) UnsetStrokeDashArray() TSpan {
+	ts.Presentation = ts.Presentation.UnsetStrokeDashArray()
+
+	return ts
+}
+
+// SetStrokeDashOffset sets the stroke dash offset of a TSpan
+func (ts TSpan) SetStrokeDashOffset(offset Length) TSpan {
+	ts.Presentation = ts.Presentation.SetStrokeDashOffset(offset)
+
+	return ts
+}
+
+// UnsetStrokeDashOffset removes the previously set stroke dash offset of a TSpan
+func (ts TSpan) UnsetStrokeDashOffset() TSpan {
+	ts.Presentation = ts.Presentation.UnsetStrokeDashOffset()
+
+	return ts
+}
+
+// SetStrokeLinecap sets the stroke linecap of a TSpan
+func (ts TSpan) SetStrokeLinecap(lc StrokeLinecap) TSpan {
+	ts.Presentation = ts.Presentation.SetStrokeLinecap(lc)
+
+	return ts
+}
+
+// UnsetStrokeLinecap removes the previously set stroke linecap of a TSpan
+func (ts TSpan) UnsetStrokeLinecap() TSpan {
+	ts.Presentation = ts.Presentation.UnsetStrokeLinecap()
+
+	return ts
+}
+
+// SetStrokeLinejoin sets the stroke linejoin of a TSpan
+func (ts TSpan) SetStrokeLinejoin(lj StrokeLinejoin) TSpan {
+	ts.Presentation = ts.Presentation.SetStrokeLinejoin(lj)
+
+	return ts
+}
+
+// UnsetStrokeLinejoin removes the previously set stroke linejoin of a TSpan
+func (ts TSpan) UnsetStrokeLinejoin() TSpan {
+	ts.Presentation = ts.Presentation.UnsetStrokeLinejoin()
+
+	return ts
+}
+
+// SetStrokeMiterlimit sets the stroke miterlimit of a TSpan
+func (ts TSpan) SetStrokeMiterlimit(ml float64) TSpan {
+	ts.Presentation = ts.Presentation.SetStrokeMiterlimit(ml)
+
+	return ts
+}
+
+// UnsetStrokeMiterlimit removes the previously set stroke miterlimit of a TSpan
+func (ts TSpan) UnsetStrokeMiterlimit() TSpan {
+	ts.Presentation = ts.Presentation.UnsetStrokeMiterlimit()
+
+	return ts
+}
+
+// SetFillRule sets the fill rule of a TSpan
+func (ts TSpan) SetFillRule(fr FillRule) TSpan {
+	ts.Presentation = ts.Presentation.SetFillRule(fr)
+
+	return ts
+}
+
+// UnsetFillRule removes the previously set fill rule of a TSpan
+func (ts TSpan) UnsetFillRule() TSpan {
+	ts.Presentation = ts.Presentation.UnsetFillRule()
+
+	return ts
+}
+
+// SetVisibility sets the visibility of a TSpan
+func (ts TSpan) SetVisibility(v Visibility) TSpan {
+	ts.Presentation = ts.Presentation.SetVisibility(v)
+
+	return ts
+}
+
+// UnsetVisibility removes the previously set visibility of a TSpan
+func (ts TSpan) UnsetVisibility() TSpan {
+	ts.Presentation = ts.Presentation.UnsetVisibility()
+
+	return ts
+}
+
+// SetDisplay sets the display of a TSpan
+func (ts TSpan) SetDisplay(d Display) TSpan {
+	ts.Presentation = ts.Presentation.SetDisplay(d)
+
+	return ts
+}
+
+// UnsetDisplay removes the previously set display of a TSpan
+func (ts TSpan) UnsetDisplay() TSpan {
+	ts.Presentation = ts.Presentation.UnsetDisplay()
+
+	return ts
+}
+
+// SetPresentation sets all presentation attributes of a Use
+func (u Use) SetPresentation(pr Presentation) Use {
+	u.Presentation = pr
+
+	return u
+}
+
+// SetStrokeWidth sets the stroke width of a Use
+func (u Use) SetStrokeWidth(strokeWidth Length) Use {
+	u.Presentation = u.Presentation.SetStrokeWidth(strokeWidth)
+
+	return u
+}
+
+// SStrokeWidth sets the stroke width of a Use (shortcut)
+func (u Use) SStrokeWidth(strokeWidth float64) Use {
+	u.Presentation = u.Presentation.SStrokeWidth(strokeWidth)
+
+	return u
+}
+
+// UnsetStrokeWidth removes the previously set stroke width of a Use
+func (u Use) UnsetStrokeWidth() Use {
+	u.Presentation = u.Presentation.UnsetStrokeWidth()
+
+	return u
+}
+
+// SetStroke sets the stroke color of a Use
+func (u Use) SetStroke(stroke Color) Use {
+	u.Presentation = u.Presentation.SetStroke(stroke)
+
+	return u
+}
+
+// SetStrokePaint sets the stroke paint of a Use, e.g. a gradient or none
+func (u Use) SetStrokePaint(stroke Paint) Use {
+	u.Presentation = u.Presentation.SetStrokePaint(stroke)
+
+	return u
+}
+
+// UnsetStroke removes the previously set stroke paint of a Use
+func (u Use) UnsetStroke() Use {
+	u.Presentation = u.Presentation.UnsetStroke()
+
+	return u
+}
+
+// SetStrokeOpacity sets the stroke opacity of a Use
+func (u Use) SetStrokeOpacity(so Opacity) Use {
+	u.Presentation = u.Presentation.SetStrokeOpacity(so)
+
+	return u
+}
+
+// UnsetStrokeOpacity removes the previously set stroke opacity of a Use
+func (u Use) UnsetStrokeOpacity() Use {
+	u.Presentation = u.Presentation.UnsetStrokeOpacity()
+
+	return u
+}
+
+// SetFill sets the fill color of a Use
+func (u Use) SetFill(fill Color) Use {
+	u.Presentation = u.Presentation.SetFill(fill)
+
+	return u
+}
+
+// SetFillPaint sets the fill paint of a Use, e.g. a gradient or none
+func (u Use) SetFillPaint(fill Paint) Use {
+	u.Presentation = u.Presentation.SetFillPaint(fill)
+
+	return u
+}
+
+// UnsetFill removes the previously set fill paint of a Use
+func (u Use) UnsetFill() Use {
+	u.Presentation = u.Presentation.UnsetFill()
+
+	return u
+}
+
+// SetFillOpacity sets the fill opacity of a Use
+func (u Use) SetFillOpacity(fo Opacity) Use {
+	u.Presentation = u.Presentation.SetFillOpacity(fo)
+
+	return u
+}
+
+// UnsetFillOpacity removes the previously set fill opacity of a Use
+func (u Use) UnsetFillOpacity() Use {
+	u.Presentation = u.Presentation.UnsetFillOpacity()
+
+	return u
+}
+
+// SetOpacity sets the opacity of a Use, which is clamped to [0, 1]
+func (u Use) SetOpacity(o float64) Use {
+	u.Presentation = u.Presentation.SetOpacity(o)
+
+	return u
+}
+
+// UnsetOpacity removes the previously set opacity of a Use
+func (u Use) UnsetOpacity() Use {
+	u.Presentation = u.Presentation.UnsetOpacity()
+
+	return u
+}
+
+// SetID sets the id attribute of a Use
+func (u Use) SetID(id string) Use {
+	u.Presentation = u.Presentation.SetID(id)
+
+	return u
+}
+
+// UnsetID removes the previously set id attribute of a Use
+func (u Use) UnsetID() Use {
+	u.Presentation = u.Presentation.UnsetID()
+
+	return u
+}
+
+// SetClass sets the class attribute of a Use
+func (u Use) SetClass(class string) Use {
+	u.Presentation = u.Presentation.SetClass(class)
+
+	return u
+}
+
+// UnsetClass removes the previously set class attribute of a Use
+func (u Use) UnsetClass() Use {
+	u.Presentation = u.Presentation.UnsetClass()
+
+	return u
+}
+
+// SetStyle sets the style attribute of a Use
+func (u Use) SetStyle(style string) Use {
+	u.Presentation = u.Presentation.SetStyle(style)
+
+	return u
+}
+
+// UnsetStyle removes the previously set style attribute of a Use
+func (u Use) UnsetStyle() Use {
+	u.Presentation = u.Presentation.UnsetStyle()
+
+	return u
+}
+
+// SetStrokeDashArray sets the stroke dash array of a Use
+func (u Use) SetStrokeDashArray(dashes ...Length) Use {
+	u.Presentation = u.Presentation.SetStrokeDashArray(dashes...)
+
+	return u
+}
+
+// UnsetStrokeDashArray removes the previously set stroke dash array of a Use
+func (u Use) UnsetStrokeDashArray() Use {
+	u.Presentation = u.Presentation.UnsetStrokeDashArray()
+
+	return u
+}
+
+// SetStrokeDashOffset sets the stroke dash offset of a Use
+func (u Use) SetStrokeDashOffset(offset Length) Use {
+	u.Presentation = u.Presentation.SetStrokeDashOffset(offset)
+
+	return u
+}
+
+// UnsetStrokeDashOffset removes the previously set stroke dash offset of a Use
+func (u Use) UnsetStrokeDashOffset() Use {
+	u.Presentation = u.Presentation.UnsetStrokeDashOffset()
+
+	return u
+}
+
+// SetStrokeLinecap sets the stroke linecap of a Use
+func (u Use) SetStrokeLinecap(lc StrokeLinecap) Use {
+	u.Presentation = u.Presentation.SetStrokeLinecap(lc)
+
+	return u
+}
+
+// UnsetStrokeLinecap removes the previously set stroke linecap of a Use
+func (u Use) UnsetStrokeLinecap() Use {
+	u.Presentation = u.Presentation.UnsetStrokeLinecap()
+
+	return u
+}
+
+// SetStrokeLinejoin sets the stroke linejoin of a Use
+func (u Use) SetStrokeLinejoin(lj StrokeLinejoin) Use {
+	u.Presentation = u.Presentation.SetStrokeLinejoin(lj)
+
+	return u
+}
+
+// UnsetStrokeLinejoin removes the previously set stroke linejoin of a Use
+func (u Use) UnsetStrokeLinejoin() Use {
+	u.Presentation = u.Presentation.UnsetStrokeLinejoin()
+
+	return u
+}
+
+// SetStrokeMiterlimit sets the stroke miterlimit of a Use
+func (u Use) SetStrokeMiterlimit(ml float64) Use {
+	u.Presentation = u.Presentation.SetStrokeMiterlimit(ml)
+
+	return u
+}
+
+// UnsetStrokeMiterlimit removes the previously set stroke miterlimit of a Use
+func (u Use) UnsetStrokeMiterlimit() Use {
+	u.Presentation = u.Presentation.UnsetStrokeMiterlimit()
+
+	return u
+}
+
+// SetFillRule sets the fill rule of a Use
+func (u Use) SetFillRule(fr FillRule) Use {
+	u.Presentation = u.Presentation.SetFillRule(fr)
+
+	return u
+}
+
+// UnsetFillRule removes the previously set fill rule of a Use
+func (u Use) UnsetFillRule() Use {
+	u.Presentation = u.Presentation.UnsetFillRule()
+
+	return u
+}
+
+// SetVisibility sets the visibility of a Use
+func (u Use) SetVisibility(v Visibility) Use {
+	u.Presentation = u.Presentation.SetVisibility(v)
+
+	return u
+}
+
+// UnsetVisibility removes the previously set visibility of a Use
+func (u Use) UnsetVisibility() Use {
+	u.Presentation = u.Presentation.UnsetVisibility()
+
+	return u
+}
+
+// SetDisplay sets the display of a Use
+func (u Use) SetDisplay(d Display) Use {
+	u.Presentation = u.Presentation.SetDisplay(d)
+
+	return u
+}
+
+// UnsetDisplay removes the previously set display of a Use
+func (u Use) UnsetDisplay() Use {
+	u.Presentation = u.Presentation.UnsetDisplay()
+
+	return u
+}
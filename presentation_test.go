@@ -0,0 +1,178 @@
+package svg
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+func TestPresentation_MarshalText(t *testing.T) {
+	ml := 4.0
+	linejoin := LinejoinBevel
+
+	tests := []struct {
+		name    string
+		element interface{}
+		want    string
+	}{
+		{
+			"circle",
+			C(1, 2, 3).
+				SetID("c").
+				SetClass("dot").
+				SetStyle("cursor: pointer").
+				SetStrokeDashArray(Lth(5), Lth(2.5)).
+				SetStrokeDashOffset(Lth(1, Percent)).
+				SetStrokeLinecap(LinecapRound).
+				SetStrokeLinejoin(LinejoinMiterClip).
+				SetStrokeMiterlimit(10).
+				SetFillRule(FillRuleEvenOdd).
+				SetVisibility(VisibilityHidden).
+				SetDisplay(DisplayNone),
+			`<circle cx="1" cy="2" r="3" id="c" class="dot" style="cursor: pointer" stroke-dasharray="5 2.5" stroke-dashoffset="1%" stroke-linecap="round" stroke-linejoin="miter-clip" stroke-miterlimit="10" fill-rule="evenodd" visibility="hidden" display="none"></circle>`,
+		},
+		{
+			"group",
+			NewGroup().SetPresentation(Presentation{ID: "g", StrokeLinejoin: &linejoin, StrokeMiterlimit: &ml}).AddAttr("foo", "Foo"),
+			`<g id="g" stroke-linejoin="bevel" stroke-miterlimit="4" foo="Foo"></g>`,
+		},
+		{
+			"text and tspan",
+			T(1, 2, TS("foo").SetClass("bar")).SetVisibility(VisibilityVisible),
+			`<text x="1" y="2" visibility="visible"><tspan class="bar">foo</tspan></text>`,
+		},
+		{
+			"paint of a group",
+			NewGroup().SetStroke(Red.ToColor()).SStrokeWidth(2).SetStrokeOpacity(O(0.5)).SetFillPaint(NonePaint()).SetOpacity(0.25),
+			`<g stroke-width="2" stroke="#ff0000" stroke-opacity="0.5" fill="none" opacity="0.25"></g>`,
+		},
+		{
+			"transparent",
+			R(0, 0, 1, 1).SetOpacity(0),
+			`<rect width="1" height="1" opacity="0"></rect>`,
+		},
+		{
+			"clamped opacity",
+			R(0, 0, 1, 1).SetOpacity(2),
+			`<rect width="1" height="1" opacity="1"></rect>`,
+		},
+		{
+			"paint of a use",
+			U("#icon", 0, 0).SetFill(Red.ToColor()).SetFillOpacity(O(0.5)),
+			`<use href="#icon" fill="#ff0000" fill-opacity="0.5"></use>`,
+		},
+		{
+			"unset",
+			R(1, 1, 2, 2).
+				SetPresentation(Presentation{ID: "r", Class: "box", Style: "cursor: pointer"}).
+				SetStroke(Red.ToColor()).SStrokeWidth(2).SetStrokeOpacity(O(0.5)).SetFill(Red.ToColor()).SetFillOpacity(O(0.5)).SetOpacity(0.5).
+				SetStrokeDashArray(Lth(1)).SetStrokeDashOffset(Lth(1)).SetStrokeLinecap(LinecapRound).SetStrokeLinejoin(LinejoinRound).
+				SetStrokeMiterlimit(2).SetFillRule(FillRuleEvenOdd).SetVisibility(VisibilityHidden).SetDisplay(DisplayNone).
+				UnsetID().UnsetClass().UnsetStyle().
+				UnsetStroke().UnsetStrokeWidth().UnsetStrokeOpacity().UnsetFill().UnsetFillOpacity().UnsetOpacity().
+				UnsetStrokeDashArray().UnsetStrokeDashOffset().UnsetStrokeLinecap().UnsetStrokeLinejoin().
+				UnsetStrokeMiterlimit().UnsetFillRule().UnsetVisibility().UnsetDisplay(),
+			`<rect x="1" y="1" width="2" height="2"></rect>`,
+		},
+		{
+			"empty dash array",
+			Pl(Pts(0, 0, 1, 1)).SetStrokeDashArray(),
+			`<polyline points="0,0 1,1" stroke-dasharray="none"></polyline>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBytes, err := xml.Marshal(tt.element)
+			if err != nil {
+				t.Errorf("xml.Marshal() error = %v, wantErr %v", err, false)
+				return
+			}
+
+			got := string(gotBytes)
+			if got != tt.want {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDashArray_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    []byte
+		want    DashArray
+		wantErr bool
+	}{
+		{"none", []byte("none"), DashArray{}, false},
+		{"comma separated", []byte("5, 10%"), DashArray{{5, ""}, {10, Percent}}, false},
+		{"whitespace separated", []byte("1 2em 3"), DashArray{{1, ""}, {2, Em}, {3, ""}}, false},
+		{"invalid", []byte("1 x"), nil, true},
+		{"empty", []byte(""), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got DashArray
+			if err := got.UnmarshalText(tt.text); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnmarshalText() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPresentation_Opacity_Unmarshal(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want *Opacity
+	}{
+		{"unset", `<rect></rect>`, nil},
+		{"transparent", `<rect opacity="0"></rect>`, &Opacity{}},
+		{"percent", `<rect opacity="50%"></rect>`, &Opacity{Number: 50, Type: OPercent}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r Rect
+			if err := xml.Unmarshal([]byte(tt.text), &r); err != nil {
+				t.Fatalf("xml.Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(r.Opacity, tt.want) {
+				t.Errorf("xml.Unmarshal() opacity = %v, want %v", r.Opacity, tt.want)
+			}
+		})
+	}
+}
+
+func TestPresentation_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		u       interface{ UnmarshalText([]byte) error }
+		text    string
+		want    interface{}
+		wantErr bool
+	}{
+		{"linecap", new(StrokeLinecap), "Square", LinecapSquare, false},
+		{"invalid linecap", new(StrokeLinecap), "pointy", StrokeLinecap(""), true},
+		{"linejoin", new(StrokeLinejoin), "arcs", LinejoinArcs, false},
+		{"invalid linejoin", new(StrokeLinejoin), "soft", StrokeLinejoin(""), true},
+		{"fill rule", new(FillRule), " nonzero ", FillRuleNonZero, false},
+		{"invalid fill rule", new(FillRule), "oddeven", FillRule(""), true},
+		{"visibility", new(Visibility), "collapse", VisibilityCollapse, false},
+		{"invalid visibility", new(Visibility), "invisible", Visibility(""), true},
+		{"display", new(Display), "table-cell", DisplayTableCell, false},
+		{"invalid display", new(Display), "flex", Display(""), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.u.UnmarshalText([]byte(tt.text)); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			got := reflect.ValueOf(tt.u).Elem().Interface()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnmarshalText() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
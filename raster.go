@@ -0,0 +1,431 @@
+package svg
+
+import (
+	"image"
+	"image/png"
+	"io"
+	"math"
+	"sort"
+)
+
+const (
+	// rasterTolerance is the largest distance in pixels allowed between a curve and its flattened form
+	rasterTolerance = 0.2
+	// rasterSubsamples is the number of sample lines per row of pixels used for anti-aliasing
+	rasterSubsamples = 16
+)
+
+// Rasterize renders an SVG tag into a new image of width x height pixels
+// The viewport of the SVG is stretched over the whole image. If either width or height is 0, it is
+// calculated from the aspect ratio of the viewport, if both are 0, the size of the viewport is used.
+// Shapes are filled and stroked with anti-aliasing. Text is not rendered, as it needs a font engine,
+// neither are paint servers, e.g. gradients, which are replaced by their fallback color if there is one.
+func Rasterize(s SVG, width, height int) *image.RGBA {
+	vw, vh := s.intrinsicSize()
+
+	switch {
+	case width > 0 && height > 0:
+	case width > 0 && vw > 0:
+		height = int(math.Round(float64(width) * vh / vw))
+	case height > 0 && vh > 0:
+		width = int(math.Round(float64(height) * vw / vh))
+	default:
+		width, height = int(math.Ceil(vw)), int(math.Ceil(vh))
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	RasterizeTo(dst, s)
+
+	return dst
+}
+
+// RasterizeTo renders an SVG tag over the existing content of dst
+// The viewport of the SVG is stretched over the bounds of dst, percentages of its width and height
+// refer to the size of dst.
+func RasterizeTo(dst *image.RGBA, s SVG) {
+	b := dst.Bounds()
+	if b.Empty() {
+		return
+	}
+
+	lr := NewLengthResolver(float64(b.Dx()), float64(b.Dy()))
+
+	vw, vh := s.ViewportSize(lr)
+	if vw <= 0 || vh <= 0 {
+		return
+	}
+
+	r := newRasterizer(dst)
+	r.PushTransform(TranslateMatrix(float64(b.Min.X), float64(b.Min.Y)).Multiply(ScaleMatrix(float64(b.Dx())/vw, float64(b.Dy())/vh)))
+
+	// an SVG with an invalid viewBox is not rendered
+	_ = Render(s, r, lr)
+}
+
+// WritePNG renders an SVG tag like Rasterize does and writes the image to w in PNG format
+func WritePNG(w io.Writer, s SVG, width, height int) error {
+	return png.Encode(w, Rasterize(s, width, height))
+}
+
+// rasterizer is the Renderer filling polygons into an image.RGBA with anti-aliasing
+// The coverage of pixels is exact along the x axis and sampled by rasterSubsamples lines along the y axis.
+type rasterizer struct {
+	dst *image.RGBA
+	// cover holds the coverage of the pixels of the current row, diff holds the changes of the coverage
+	// of fully covered pixels, which are summed up at the end of the row into coverage
+	cover    []float64
+	diff     []float64
+	coverage []float64
+	// states holds the states pushed, the last one is the current state
+	states []rasterState
+	// layers holds the images and opacities of the layers pushed, dst is the image of the current layer
+	layers []rasterLayer
+	paint  PaintStyle
+}
+
+// rasterLayer is an image drawn into before the layer started by PushLayer
+type rasterLayer struct {
+	dst     *image.RGBA
+	opacity float64
+}
+
+// rasterState is the part of the state of a rasterizer saved by PushTransform
+type rasterState struct {
+	// m maps the current user space to pixels
+	m Matrix
+	// clip holds the coverage of the pixels of dst by the clipping region row by row, nil if there is none
+	clip []float64
+}
+
+func newRasterizer(dst *image.RGBA) *rasterizer {
+	w := dst.Bounds().Dx()
+
+	return &rasterizer{
+		dst:      dst,
+		cover:    make([]float64, w+1),
+		diff:     make([]float64, w+1),
+		coverage: make([]float64, w),
+		states:   []rasterState{{m: IdentityMatrix()}},
+	}
+}
+
+// FontMetrics returns nil, as text is not rendered without a font engine
+func (r *rasterizer) FontMetrics() FontMetrics {
+	return nil
+}
+
+func (r *rasterizer) PushTransform(m Matrix) {
+	st := r.state()
+	st.m = st.m.Multiply(m)
+
+	r.states = append(r.states, st)
+}
+
+func (r *rasterizer) PopTransform() {
+	r.states = r.states[:len(r.states)-1]
+}
+
+func (r *rasterizer) state() rasterState {
+	return r.states[len(r.states)-1]
+}
+
+func (r *rasterizer) SetPaint(ps PaintStyle) {
+	r.paint = ps
+}
+
+func (r *rasterizer) FillPath(pd PathData) {
+	if r.paint.Fill == nil {
+		return
+	}
+
+	if sps, _, ok := r.flatten(pd); ok {
+		r.fill(transformPolygons(subpathPolygons(sps), r.state().m), r.paint.FillRule, *r.paint.Fill)
+	}
+}
+
+func (r *rasterizer) StrokePath(pd PathData) {
+	if r.paint.Stroke == nil {
+		return
+	}
+
+	// strokes are built in user space, so they are transformed along with the shape
+	if sps, tolerance, ok := r.flatten(pd); ok {
+		r.fill(transformPolygons(strokePolygons(sps, r.paint, tolerance), r.state().m), FillRuleNonZero, *r.paint.Stroke)
+	}
+}
+
+func (r *rasterizer) DrawText(float64, float64, string, TextAnchor) {}
+
+// Clip intersects the clipping region with the area of pd, pixels outside of dst stay clipped out
+func (r *rasterizer) Clip(pd PathData, rule FillRule) {
+	st := r.state()
+
+	sps, _, ok := r.flatten(pd)
+	if !ok {
+		return
+	}
+
+	b := r.dst.Bounds()
+	w := b.Dx()
+	clip := make([]float64, w*b.Dy())
+
+	r.rasterize(transformPolygons(subpathPolygons(sps), st.m), rule, func(y int, coverage []float64) {
+		o := (y - b.Min.Y) * w
+		for i, a := range coverage {
+			if st.clip != nil {
+				a *= st.clip[o+i]
+			}
+			clip[o+i] = a
+		}
+	})
+
+	st.clip = clip
+	r.states[len(r.states)-1] = st
+}
+
+// PushLayer starts drawing into a new transparent image of the size of dst
+func (r *rasterizer) PushLayer(opacity float64) {
+	r.layers = append(r.layers, rasterLayer{dst: r.dst, opacity: opacity})
+	r.dst = image.NewRGBA(r.dst.Bounds())
+}
+
+// PopLayer composites the image of the current layer with its opacity over the image drawn into before
+func (r *rasterizer) PopLayer() {
+	l := r.layers[len(r.layers)-1]
+	r.layers = r.layers[:len(r.layers)-1]
+
+	src := r.dst
+	r.dst = l.dst
+
+	b := src.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		so, do := src.PixOffset(b.Min.X, y), r.dst.PixOffset(b.Min.X, y)
+		srow, drow := src.Pix[so:so+4*b.Dx()], r.dst.Pix[do:do+4*b.Dx()]
+
+		for i := 0; i < len(srow); i += 4 {
+			// both images are premultiplied by alpha
+			a := float64(srow[i+3]) / 255 * l.opacity
+			if a <= 0 {
+				continue
+			}
+
+			for k := i; k < i+4; k++ {
+				drow[k] = uint8(float64(srow[k])*l.opacity + float64(drow[k])*(1-a) + 0.5)
+			}
+		}
+	}
+}
+
+// flatten flattens a PathData in user space with a tolerance giving rasterTolerance in pixels, which is
+// returned as well, it reports false if the current transformation collapses the user space
+func (r *rasterizer) flatten(pd PathData) ([]subpath, float64, bool) {
+	scale := math.Sqrt(math.Abs(r.state().m.Determinant()))
+	if scale == 0 {
+		return nil, 0, false
+	}
+
+	tolerance := rasterTolerance / scale
+
+	return flattenPath(pd, IdentityMatrix(), tolerance), tolerance, true
+}
+
+func subpathPolygons(sps []subpath) [][]Point {
+	polygons := make([][]Point, 0, len(sps))
+	for _, sp := range sps {
+		polygons = append(polygons, sp.Points)
+	}
+
+	return polygons
+}
+
+func transformPolygons(polygons [][]Point, m Matrix) [][]Point {
+	res := make([][]Point, 0, len(polygons))
+	for _, poly := range polygons {
+		tp := make([]Point, 0, len(poly))
+		for _, p := range poly {
+			x, y := m.Apply(p.X, p.Y)
+			tp = append(tp, Point{X: x, Y: y})
+		}
+		res = append(res, tp)
+	}
+
+	return res
+}
+
+// edge is a non-horizontal side of a polygon, Dir is 1 if it goes downwards, -1 otherwise
+type edge struct {
+	X0, Y0, X1, Y1 float64
+	Dir            int
+}
+
+type crossing struct {
+	X   float64
+	Dir int
+}
+
+// fill paints the area of implicitly closed polygons with c
+func (r *rasterizer) fill(polygons [][]Point, rule FillRule, c Color) {
+	r.rasterize(polygons, rule, func(y int, coverage []float64) {
+		r.blendRow(y, coverage, c)
+	})
+}
+
+// rasterize calls row with the coverage of the pixels of each row by the area of implicitly closed
+// polygons, rows which do not intersect the area are skipped
+func (r *rasterizer) rasterize(polygons [][]Point, rule FillRule, row func(y int, coverage []float64)) {
+	var edges []edge
+
+	for _, poly := range polygons {
+		for i := range poly {
+			p, q := poly[i], poly[(i+1)%len(poly)]
+			switch {
+			case p.Y < q.Y:
+				edges = append(edges, edge{X0: p.X, Y0: p.Y, X1: q.X, Y1: q.Y, Dir: 1})
+			case p.Y > q.Y:
+				edges = append(edges, edge{X0: q.X, Y0: q.Y, X1: p.X, Y1: p.Y, Dir: -1})
+			}
+		}
+	}
+
+	if len(edges) == 0 {
+		return
+	}
+
+	sort.Slice(edges, func(i, j int) bool { return edges[i].Y0 < edges[j].Y0 })
+
+	maxY := edges[0].Y1
+	for _, e := range edges {
+		maxY = math.Max(maxY, e.Y1)
+	}
+
+	b := r.dst.Bounds()
+	y0 := int(math.Max(float64(b.Min.Y), math.Floor(edges[0].Y0)))
+	y1 := int(math.Min(float64(b.Max.Y), math.Ceil(maxY)))
+
+	inside := func(winding int) bool {
+		if rule == FillRuleEvenOdd {
+			return winding%2 != 0
+		}
+
+		return winding != 0
+	}
+
+	var (
+		active    []edge
+		crossings []crossing
+		next      int
+	)
+
+	for y := y0; y < y1; y++ {
+		// edges which end above the row are dropped, the ones starting in the row are added
+		kept := active[:0]
+		for _, e := range active {
+			if e.Y1 > float64(y) {
+				kept = append(kept, e)
+			}
+		}
+		active = kept
+
+		for next < len(edges) && edges[next].Y0 < float64(y+1) {
+			if edges[next].Y1 > float64(y) {
+				active = append(active, edges[next])
+			}
+			next++
+		}
+
+		if len(active) == 0 {
+			continue
+		}
+
+		for s := 0; s < rasterSubsamples; s++ {
+			sy := float64(y) + (float64(s)+0.5)/rasterSubsamples
+
+			crossings = crossings[:0]
+			for _, e := range active {
+				if sy >= e.Y0 && sy < e.Y1 {
+					x := e.X0 + (sy-e.Y0)*(e.X1-e.X0)/(e.Y1-e.Y0)
+					crossings = append(crossings, crossing{X: x - float64(b.Min.X), Dir: e.Dir})
+				}
+			}
+
+			sort.Slice(crossings, func(i, j int) bool { return crossings[i].X < crossings[j].X })
+
+			winding, start := 0, 0.0
+			for _, cr := range crossings {
+				was := inside(winding)
+				winding += cr.Dir
+
+				switch is := inside(winding); {
+				case !was && is:
+					start = cr.X
+				case was && !is:
+					r.addSpan(start, cr.X, 1.0/rasterSubsamples)
+				}
+			}
+		}
+
+		r.sumRow()
+		row(y, r.coverage)
+	}
+}
+
+// addSpan adds coverage w to the pixels of the current row between x0 and x1
+func (r *rasterizer) addSpan(x0, x1, w float64) {
+	width := float64(len(r.cover) - 1)
+
+	x0 = math.Max(0, math.Min(width, x0))
+	x1 = math.Max(0, math.Min(width, x1))
+	if x1 <= x0 {
+		return
+	}
+
+	i0, i1 := int(x0), int(x1)
+	if i0 == i1 {
+		r.cover[i0] += (x1 - x0) * w
+
+		return
+	}
+
+	r.cover[i0] += (float64(i0+1) - x0) * w
+	r.diff[i0+1] += w
+	r.diff[i1] -= w
+	r.cover[i1] += (x1 - float64(i1)) * w
+}
+
+// sumRow sums up the accumulated coverage of the current row into coverage, then resets it
+func (r *rasterizer) sumRow() {
+	full := 0.0
+	for i := range r.coverage {
+		full += r.diff[i]
+		r.coverage[i] = math.Min(1, full+r.cover[i])
+		r.cover[i], r.diff[i] = 0, 0
+	}
+
+	r.cover[len(r.cover)-1], r.diff[len(r.diff)-1] = 0, 0
+}
+
+// blendRow composites c over the pixels of row y with the given coverage, limited by the clipping region
+func (r *rasterizer) blendRow(y int, coverage []float64, c Color) {
+	b := r.dst.Bounds()
+	ca := float64(c.A) / 255
+	clip := r.state().clip
+
+	for i, a := range coverage {
+		a *= ca
+		if clip != nil {
+			a *= clip[(y-b.Min.Y)*b.Dx()+i]
+		}
+
+		if a <= 0 {
+			continue
+		}
+
+		// the pixels of image.RGBA are premultiplied by alpha, Color is not
+		o := r.dst.PixOffset(b.Min.X+i, y)
+		pix := r.dst.Pix[o : o+4 : o+4]
+		pix[0] = uint8(float64(c.R)*a + float64(pix[0])*(1-a) + 0.5)
+		pix[1] = uint8(float64(c.G)*a + float64(pix[1])*(1-a) + 0.5)
+		pix[2] = uint8(float64(c.B)*a + float64(pix[2])*(1-a) + 0.5)
+		pix[3] = uint8(255*a + float64(pix[3])*(1-a) + 0.5)
+	}
+}
@@ -0,0 +1,250 @@
+package svg
+
+import (
+	"math"
+)
+
+// strokePolygons returns polygons covering the stroke of flattened subpaths in the same coordinate system
+// The polygons are meant to be filled with the nonzero rule, they all have the same orientation,
+// so overlapping segments, joins and caps do not cancel each other out.
+func strokePolygons(sps []subpath, ps PaintStyle, tolerance float64) [][]Point {
+	if len(ps.Dashes) > 0 {
+		sps = dashSubpaths(sps, ps.Dashes, ps.DashOffset)
+	}
+
+	hw := ps.StrokeWidth / 2
+
+	var res [][]Point
+
+	add := func(poly []Point) {
+		if polygonArea(poly) < 0 {
+			for i, j := 0, len(poly)-1; i < j; i, j = i+1, j-1 {
+				poly[i], poly[j] = poly[j], poly[i]
+			}
+		}
+		res = append(res, poly)
+	}
+
+	for _, sp := range sps {
+		pts := distinctPoints(sp.Points)
+		closed := sp.Closed && len(pts) > 2
+		if sp.Closed && len(pts) > 1 && pts[0] == pts[len(pts)-1] {
+			pts = pts[:len(pts)-1]
+			closed = len(pts) > 2
+		}
+
+		// a zero length subpath is only painted by round and square caps
+		if len(pts) == 1 {
+			switch ps.Linecap {
+			case LinecapRound:
+				add(circlePolygon(pts[0], hw, tolerance))
+			case LinecapSquare:
+				p := pts[0]
+				add([]Point{{X: p.X - hw, Y: p.Y - hw}, {X: p.X + hw, Y: p.Y - hw}, {X: p.X + hw, Y: p.Y + hw}, {X: p.X - hw, Y: p.Y + hw}})
+			}
+			continue
+		}
+
+		n := len(pts) - 1
+		if closed {
+			n = len(pts)
+		}
+
+		for i := 0; i < n; i++ {
+			p, q := pts[i], pts[(i+1)%len(pts)]
+			nx, ny := strokeNormal(p, q, hw)
+			add([]Point{{X: p.X + nx, Y: p.Y + ny}, {X: q.X + nx, Y: q.Y + ny}, {X: q.X - nx, Y: q.Y - ny}, {X: p.X - nx, Y: p.Y - ny}})
+		}
+
+		for i := 0; i < len(pts); i++ {
+			if !closed && (i == 0 || i == len(pts)-1) {
+				continue
+			}
+
+			prev, next := pts[(i+len(pts)-1)%len(pts)], pts[(i+1)%len(pts)]
+			if join := joinPolygon(prev, pts[i], next, ps, hw, tolerance); join != nil {
+				add(join)
+			}
+		}
+
+		if !closed {
+			addCap := func(p, from Point) {
+				if c := capPolygon(p, from, ps.Linecap, hw, tolerance); c != nil {
+					add(c)
+				}
+			}
+			addCap(pts[0], pts[1])
+			addCap(pts[len(pts)-1], pts[len(pts)-2])
+		}
+	}
+
+	return res
+}
+
+// strokeNormal returns the vector perpendicular to the segment from p to q with a length of hw
+func strokeNormal(p, q Point, hw float64) (float64, float64) {
+	l := math.Hypot(q.X-p.X, q.Y-p.Y)
+
+	return -(q.Y - p.Y) / l * hw, (q.X - p.X) / l * hw
+}
+
+// joinPolygon returns the polygon filling the gap between two segments on the outer side of their
+// joint p, or nil if there is no gap
+func joinPolygon(prev, p, next Point, ps PaintStyle, hw, tolerance float64) []Point {
+	if ps.Linejoin == LinejoinRound {
+		return circlePolygon(p, hw, tolerance)
+	}
+
+	n1x, n1y := strokeNormal(prev, p, hw)
+	n2x, n2y := strokeNormal(p, next, hw)
+
+	// the path turns towards the side of the normals if the cross product is positive
+	cr := n1x*n2y - n1y*n2x
+	if cr == 0 {
+		return nil
+	}
+
+	side := -1.0
+	if cr < 0 {
+		side = 1
+	}
+
+	a := Point{X: p.X + side*n1x, Y: p.Y + side*n1y}
+	b := Point{X: p.X + side*n2x, Y: p.Y + side*n2y}
+
+	cos := (n1x*n2x + n1y*n2y) / (hw * hw)
+	if ps.Linejoin != LinejoinBevel && 1+cos > 0 && math.Sqrt(2/(1+cos)) <= ps.Miterlimit {
+		k := side / (1 + cos)
+		tip := Point{X: p.X + (n1x+n2x)*k, Y: p.Y + (n1y+n2y)*k}
+
+		return []Point{p, a, tip, b}
+	}
+
+	return []Point{p, a, b}
+}
+
+// capPolygon returns the cap at the end p of an open subpath, from is the other end of the last segment
+func capPolygon(p, from Point, lc StrokeLinecap, hw, tolerance float64) []Point {
+	switch lc {
+	case LinecapRound:
+		return circlePolygon(p, hw, tolerance)
+	case LinecapSquare:
+		nx, ny := strokeNormal(from, p, hw)
+		// the direction of the segment is the normal rotated back
+		dx, dy := ny, -nx
+
+		return []Point{
+			{X: p.X + nx, Y: p.Y + ny},
+			{X: p.X + nx + dx, Y: p.Y + ny + dy},
+			{X: p.X - nx + dx, Y: p.Y - ny + dy},
+			{X: p.X - nx, Y: p.Y - ny},
+		}
+	}
+
+	return nil
+}
+
+// circlePolygon approximates a circle with a polygon, keeping its sides within tolerance of the circle
+func circlePolygon(c Point, r, tolerance float64) []Point {
+	n := 8
+	if tolerance < r {
+		n = int(math.Max(8, math.Ceil(math.Pi/math.Acos(1-tolerance/r))))
+	}
+
+	res := make([]Point, 0, n)
+	for i := 0; i < n; i++ {
+		sin, cos := math.Sincos(2 * math.Pi * float64(i) / float64(n))
+		res = append(res, Point{X: c.X + r*cos, Y: c.Y + r*sin})
+	}
+
+	return res
+}
+
+// polygonArea returns the signed area of a polygon, which is positive if it is clockwise on screen
+func polygonArea(poly []Point) float64 {
+	a := 0.0
+	for i := range poly {
+		p, q := poly[i], poly[(i+1)%len(poly)]
+		a += p.X*q.Y - q.X*p.Y
+	}
+
+	return a / 2
+}
+
+// distinctPoints drops points which are the same as the previous one
+func distinctPoints(pts []Point) []Point {
+	res := make([]Point, 0, len(pts))
+	for _, p := range pts {
+		if len(res) == 0 || res[len(res)-1] != p {
+			res = append(res, p)
+		}
+	}
+
+	return res
+}
+
+// dashSubpaths splits flattened subpaths into dashes, every subpath starts at the beginning of the pattern
+// dashes holds the lengths of dashes and gaps, it must have an even number of values with a positive sum.
+// See: https://www.w3.org/TR/SVG11/painting.html#StrokeDasharrayProperty
+func dashSubpaths(sps []subpath, dashes []float64, offset float64) []subpath {
+	total := 0.0
+	for _, d := range dashes {
+		total += d
+	}
+
+	var res []subpath
+
+	for _, sp := range sps {
+		pts := sp.Points
+		if sp.Closed {
+			pts = append(pts[:len(pts):len(pts)], pts[0])
+		}
+
+		i, left := 0, math.Mod(offset, total)
+		if left < 0 {
+			left += total
+		}
+		for left >= dashes[i] {
+			left -= dashes[i]
+			i = (i + 1) % len(dashes)
+		}
+		left = dashes[i] - left
+
+		var cur []Point
+		if i%2 == 0 {
+			cur = []Point{pts[0]}
+		}
+
+		for j := 1; j < len(pts); j++ {
+			a, b := pts[j-1], pts[j]
+			l := math.Hypot(b.X-a.X, b.Y-a.Y)
+
+			pos := 0.0
+			for l-pos > left {
+				pos += left
+				p := Point{X: a.X + (b.X-a.X)*pos/l, Y: a.Y + (b.Y-a.Y)*pos/l}
+
+				if i%2 == 0 {
+					res = append(res, subpath{Points: append(cur, p)})
+					cur = nil
+				} else {
+					cur = []Point{p}
+				}
+
+				i = (i + 1) % len(dashes)
+				left = dashes[i]
+			}
+
+			left -= l - pos
+			if i%2 == 0 {
+				cur = append(cur, b)
+			}
+		}
+
+		if len(cur) > 0 {
+			res = append(res, subpath{Points: cur})
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,140 @@
+package svg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDashSubpaths(t *testing.T) {
+	line := []subpath{{Points: []Point{{0, 0}, {10, 0}}}}
+
+	tests := []struct {
+		name   string
+		sps    []subpath
+		dashes []float64
+		offset float64
+		want   []subpath
+	}{
+		{
+			"dashes and gaps",
+			line,
+			[]float64{3, 2},
+			0,
+			[]subpath{
+				{Points: []Point{{0, 0}, {3, 0}}},
+				{Points: []Point{{5, 0}, {8, 0}}},
+			},
+		},
+		{
+			"offset",
+			line,
+			[]float64{3, 2},
+			4,
+			[]subpath{
+				{Points: []Point{{1, 0}, {4, 0}}},
+				{Points: []Point{{6, 0}, {9, 0}}},
+			},
+		},
+		{
+			"negative offset",
+			line,
+			[]float64{3, 2},
+			-1,
+			[]subpath{
+				{Points: []Point{{1, 0}, {4, 0}}},
+				{Points: []Point{{6, 0}, {9, 0}}},
+			},
+		},
+		{
+			"dash around a corner",
+			[]subpath{{Points: []Point{{0, 0}, {2, 0}, {2, 2}}}},
+			[]float64{3, 10},
+			0,
+			[]subpath{
+				{Points: []Point{{0, 0}, {2, 0}, {2, 1}}},
+			},
+		},
+		{
+			"closed subpath",
+			[]subpath{{Points: []Point{{0, 0}, {4, 0}, {4, 4}, {0, 4}}, Closed: true}},
+			[]float64{6, 6},
+			0,
+			[]subpath{
+				{Points: []Point{{0, 0}, {4, 0}, {4, 2}}},
+				{Points: []Point{{0, 4}, {0, 0}}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dashSubpaths(tt.sps, tt.dashes, tt.offset); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("dashSubpaths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoinPolygon(t *testing.T) {
+	prev, p, next := Point{0, 0}, Point{10, 0}, Point{10, 10}
+
+	tests := []struct {
+		name string
+		ps   PaintStyle
+		want []Point
+	}{
+		{
+			"miter",
+			PaintStyle{Linejoin: LinejoinMiter, Miterlimit: 4},
+			[]Point{{10, 0}, {10, -1}, {11, -1}, {11, 0}},
+		},
+		{
+			"miter limit exceeded",
+			PaintStyle{Linejoin: LinejoinMiter, Miterlimit: 1.2},
+			[]Point{{10, 0}, {10, -1}, {11, 0}},
+		},
+		{
+			"bevel",
+			PaintStyle{Linejoin: LinejoinBevel, Miterlimit: 4},
+			[]Point{{10, 0}, {10, -1}, {11, 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := joinPolygon(prev, p, next, tt.ps, 1, 0.1); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("joinPolygon() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := joinPolygon(prev, p, Point{20, 0}, PaintStyle{Linejoin: LinejoinMiter, Miterlimit: 4}, 1, 0.1); got != nil {
+		t.Errorf("joinPolygon() of collinear segments = %v, want nil", got)
+	}
+}
+
+func TestStrokePolygons(t *testing.T) {
+	sps := []subpath{{Points: []Point{{0, 0}, {10, 0}, {10, 10}}}}
+
+	tests := []struct {
+		name string
+		ps   PaintStyle
+		want int
+	}{
+		{"segments and join", PaintStyle{StrokeWidth: 2, Linecap: LinecapButt, Linejoin: LinejoinMiter, Miterlimit: 4}, 3},
+		{"caps", PaintStyle{StrokeWidth: 2, Linecap: LinecapSquare, Linejoin: LinejoinMiter, Miterlimit: 4}, 5},
+		{"dashes", PaintStyle{StrokeWidth: 2, Linecap: LinecapButt, Linejoin: LinejoinMiter, Miterlimit: 4, Dashes: []float64{4, 4}}, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := strokePolygons(sps, tt.ps, 0.1)
+			if len(got) != tt.want {
+				t.Fatalf("strokePolygons() = %v, want %d polygons", got, tt.want)
+			}
+
+			for _, poly := range got {
+				if polygonArea(poly) < 0 {
+					t.Errorf("strokePolygons() polygon %v has the wrong orientation", poly)
+				}
+			}
+		})
+	}
+}
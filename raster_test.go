@@ -0,0 +1,269 @@
+package svg
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"testing"
+)
+
+func TestRasterize(t *testing.T) {
+	red := Red.ToColor()
+	eight := Lth(8)
+	hidden := VisibilityHidden
+	none := DisplayNone
+
+	tests := []struct {
+		name string
+		s    SVG
+		// want maps pixels to their expected colors
+		want map[image.Point]color.RGBA
+	}{
+		{
+			"rect",
+			NewSVG(20, 20, R(2, 2, 10, 10).SetFill(red)),
+			map[image.Point]color.RGBA{
+				{0, 0}:   {},
+				{2, 2}:   {255, 0, 0, 255},
+				{11, 11}: {255, 0, 0, 255},
+				{12, 12}: {},
+			},
+		},
+		{
+			"anti-aliased edge",
+			NewSVG(20, 20, R(2.5, 2, 10, 10)),
+			map[image.Point]color.RGBA{
+				{2, 5}: {0, 0, 0, 128},
+				{3, 5}: {0, 0, 0, 255},
+			},
+		},
+		{
+			"circle",
+			NewSVG(20, 20, C(10, 10, 8).SetFill(red)),
+			map[image.Point]color.RGBA{
+				{10, 10}: {255, 0, 0, 255},
+				{3, 10}:  {255, 0, 0, 255},
+				{3, 3}:   {},
+				{19, 10}: {},
+			},
+		},
+		{
+			"rounded rect",
+			NewSVG(20, 20, NewRect(nil, nil, &Length{Number: 20}, &Length{Number: 20}, &eight, nil)),
+			map[image.Point]color.RGBA{
+				{0, 0}:   {},
+				{10, 0}:  {0, 0, 0, 255},
+				{0, 10}:  {0, 0, 0, 255},
+				{19, 19}: {},
+			},
+		},
+		{
+			"viewBox",
+			NewSVG(20, 20, R(0, 0, 5, 5)).SetViewBox(VB(0, 0, 10, 10)),
+			map[image.Point]color.RGBA{
+				{9, 9}:   {0, 0, 0, 255},
+				{10, 10}: {},
+			},
+		},
+		{
+			"group transform",
+			NewSVG(20, 20, NewGroup(R(0, 0, 5, 5)).SetTransform(NewTransform().Translate(10, 10))),
+			map[image.Point]color.RGBA{
+				{4, 4}:   {},
+				{12, 12}: {0, 0, 0, 255},
+			},
+		},
+		{
+			"opacities",
+			NewSVG(20, 20,
+				R(0, 0, 10, 10).SetFillOpacity(O(50, OPercent)),
+				R(10, 0, 10, 10).SetOpacity(0.5),
+				R(0, 10, 10, 10).SetOpacity(0.5).SetFillOpacity(O(0.5)),
+			),
+			map[image.Point]color.RGBA{
+				{5, 5}:   {0, 0, 0, 128},
+				{15, 5}:  {0, 0, 0, 128},
+				{5, 15}:  {0, 0, 0, 64},
+				{15, 15}: {},
+			},
+		},
+		{
+			"opacity of fill and stroke",
+			NewSVG(20, 20, R(4, 4, 12, 12).SetFill(red).SetStroke(Blue.ToColor()).SetStrokeWidth(Lth(4)).SetOpacity(0.5)),
+			map[image.Point]color.RGBA{
+				{3, 10}:  {0, 0, 128, 128},
+				{5, 10}:  {0, 0, 128, 128},
+				{10, 10}: {128, 0, 0, 128},
+				{1, 10}:  {},
+			},
+		},
+		{
+			"group opacity",
+			NewSVG(20, 20, NewGroup(R(0, 0, 15, 20).SetFill(red), R(5, 0, 15, 20).SetFill(red)).SetOpacity(0.5)),
+			map[image.Point]color.RGBA{
+				{2, 10}:  {128, 0, 0, 128},
+				{10, 10}: {128, 0, 0, 128},
+				{17, 10}: {128, 0, 0, 128},
+			},
+		},
+		{
+			"transparent",
+			NewSVG(20, 20, R(0, 0, 20, 20).SetFill(red).SetStroke(red).SetOpacity(0), NewGroup(R(0, 0, 20, 20)).SetOpacity(0)),
+			map[image.Point]color.RGBA{
+				{10, 10}: {},
+			},
+		},
+		{
+			"blending",
+			NewSVG(20, 20, R(0, 0, 20, 20).SetFill(red), R(0, 0, 20, 20).SetFill(Blue.ToColor()).SetOpacity(0.5)),
+			map[image.Point]color.RGBA{
+				{5, 5}: {127, 0, 128, 255},
+			},
+		},
+		{
+			"stroke",
+			NewSVG(20, 20, L(2, 10, 18, 10).SetStroke(red).SetStrokeWidth(Lth(2))),
+			map[image.Point]color.RGBA{
+				{10, 9}:  {255, 0, 0, 255},
+				{10, 10}: {255, 0, 0, 255},
+				{10, 11}: {},
+				{1, 10}:  {},
+			},
+		},
+		{
+			"square cap",
+			NewSVG(20, 20, L(2, 10, 18, 10).SetStroke(red).SetStrokeWidth(Lth(2)).SetStrokeLinecap(LinecapSquare)),
+			map[image.Point]color.RGBA{
+				{1, 10}: {255, 0, 0, 255},
+				{0, 10}: {},
+			},
+		},
+		{
+			"dashes",
+			NewSVG(20, 20, L(0, 10, 20, 10).SetStroke(red).SetStrokeWidth(Lth(2)).SetStrokeDashArray(Lth(5))),
+			map[image.Point]color.RGBA{
+				{2, 10}:  {255, 0, 0, 255},
+				{7, 10}:  {},
+				{12, 10}: {255, 0, 0, 255},
+			},
+		},
+		{
+			"even-odd polygon",
+			NewSVG(20, 20, NewPath(nil).SetD(NewPathData().
+				MoveTo(0, 0).LineTo(20, 0).LineTo(20, 20).LineTo(0, 20).ClosePath().
+				MoveTo(5, 5).LineTo(15, 5).LineTo(15, 15).LineTo(5, 15).ClosePath(),
+			).SetFillRule(FillRuleEvenOdd)),
+			map[image.Point]color.RGBA{
+				{2, 2}:   {0, 0, 0, 255},
+				{10, 10}: {},
+			},
+		},
+		{
+			"nonzero polygon",
+			NewSVG(20, 20, Pg(Points{{0, 0}, {20, 0}, {20, 20}, {0, 20}})),
+			map[image.Point]color.RGBA{
+				{10, 10}: {0, 0, 0, 255},
+			},
+		},
+		{
+			"hidden",
+			NewSVG(20, 20,
+				NewGroup(R(0, 0, 10, 10)).SetDisplay(none),
+				NewGroup(R(10, 0, 10, 10)).SetVisibility(hidden),
+			),
+			map[image.Point]color.RGBA{
+				{5, 5}:  {},
+				{15, 5}: {},
+			},
+		},
+		{
+			"symbol viewport clip",
+			NewSVG(20, 20,
+				NewDefs(NewSymbol("icon", R(-5, -5, 20, 20)).SetViewBox(VB(0, 0, 10, 10))),
+				U("#icon", 5, 5).SetWidth(Lth(10)).SetHeight(Lth(10)),
+			),
+			map[image.Point]color.RGBA{
+				{4, 4}:   {},
+				{5, 5}:   {0, 0, 0, 255},
+				{14, 14}: {0, 0, 0, 255},
+				{15, 15}: {},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img := Rasterize(tt.s, 0, 0)
+			if b := img.Bounds(); b.Dx() != 20 || b.Dy() != 20 {
+				t.Fatalf("Rasterize() bounds = %v, want 20x20", b)
+			}
+
+			for p, want := range tt.want {
+				if got := img.RGBAAt(p.X, p.Y); !rgbaAlmostEqual(got, want) {
+					t.Errorf("Rasterize() pixel %v = %v, want %v", p, got, want)
+				}
+			}
+		})
+	}
+}
+
+func rgbaAlmostEqual(c1, c2 color.RGBA) bool {
+	near := func(a, b uint8) bool {
+		return math.Abs(float64(a)-float64(b)) <= 2
+	}
+
+	return near(c1.R, c2.R) && near(c1.G, c2.G) && near(c1.B, c2.B) && near(c1.A, c2.A)
+}
+
+func TestRasterize_size(t *testing.T) {
+	tests := []struct {
+		name          string
+		s             SVG
+		width, height int
+		want          image.Rectangle
+	}{
+		{"viewport", NewSVG(30, 20), 0, 0, image.Rect(0, 0, 30, 20)},
+		{"width", NewSVG(30, 20), 60, 0, image.Rect(0, 0, 60, 40)},
+		{"height", NewSVG(30, 20), 0, 10, image.Rect(0, 0, 15, 10)},
+		{"both", NewSVG(30, 20), 5, 5, image.Rect(0, 0, 5, 5)},
+		{"viewBox", NewSVG(0, 0).SetViewBox(VB(0, 0, 40, 10)), 0, 0, image.Rect(0, 0, 40, 10)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Rasterize(tt.s, tt.width, tt.height).Bounds(); got != tt.want {
+				t.Errorf("Rasterize() bounds = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRasterizeTo(t *testing.T) {
+	dst := image.NewRGBA(image.Rect(10, 10, 30, 30))
+
+	RasterizeTo(dst, NewSVG(10, 10, R(0, 0, 5, 5)))
+
+	if got := dst.RGBAAt(19, 19); got.A != 255 {
+		t.Errorf("RasterizeTo() pixel (19, 19) = %v, want black", got)
+	}
+	if got := dst.RGBAAt(20, 20); got.A != 0 {
+		t.Errorf("RasterizeTo() pixel (20, 20) = %v, want transparent", got)
+	}
+}
+
+func TestWritePNG(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := WritePNG(&buf, NewSVG(30, 20, C(10, 10, 5)), 0, 0); err != nil {
+		t.Fatalf("WritePNG() error = %v", err)
+	}
+
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("png.Decode() error = %v", err)
+	}
+
+	if got := img.Bounds(); got != image.Rect(0, 0, 30, 20) {
+		t.Errorf("WritePNG() bounds = %v, want 30x20", got)
+	}
+}
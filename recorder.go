@@ -0,0 +1,148 @@
+package svg
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RenderOp is the Renderer method a RenderCall was made to
+type RenderOp string
+
+const (
+	OpPushTransform RenderOp = "push"
+	OpPopTransform  RenderOp = "pop"
+	OpSetPaint      RenderOp = "paint"
+	OpFillPath      RenderOp = "fill"
+	OpStrokePath    RenderOp = "stroke"
+	OpDrawText      RenderOp = "text"
+	OpClip          RenderOp = "clip"
+	OpPushLayer     RenderOp = "layer"
+	OpPopLayer      RenderOp = "composite"
+)
+
+// RenderCall is a call of a Renderer recorded by a Recorder, only the arguments of its Op are set
+type RenderCall struct {
+	Op       RenderOp
+	Matrix   Matrix
+	Paint    PaintStyle
+	Path     PathData
+	FillRule FillRule
+	X        float64
+	Y        float64
+	Text     string
+	Anchor   TextAnchor
+	Opacity  float64
+}
+
+// String formats a RenderCall as its Op followed by its arguments, e.g. "fill M1 2 L3 4 Z"
+// Colors are formatted with their alpha channel, as #rrggbbaa if they are not opaque.
+func (rc RenderCall) String() string {
+	switch rc.Op {
+	case OpPushTransform:
+		return fmt.Sprintf("%s %v", rc.Op, rc.Matrix.ToTransform())
+	case OpSetPaint:
+		s := fmt.Sprintf("%s fill %s stroke %s", rc.Op, recordedColor(rc.Paint.Fill), recordedColor(rc.Paint.Stroke))
+		if rc.Paint.Stroke != nil {
+			s += fmt.Sprintf(" width %v", rc.Paint.StrokeWidth)
+		}
+
+		return s
+	case OpFillPath, OpStrokePath:
+		return fmt.Sprintf("%s %v", rc.Op, rc.Path)
+	case OpDrawText:
+		anchor, _ := rc.Anchor.MarshalText()
+
+		return fmt.Sprintf("%s %v %v %s %q", rc.Op, rc.X, rc.Y, anchor, rc.Text)
+	case OpClip:
+		return fmt.Sprintf("%s %s %v", rc.Op, rc.FillRule, rc.Path)
+	case OpPushLayer:
+		return fmt.Sprintf("%s %v", rc.Op, rc.Opacity)
+	}
+
+	return string(rc.Op)
+}
+
+func recordedColor(c *Color) string {
+	if c == nil {
+		return "none"
+	}
+
+	return c.Format(ColorHexAlpha)
+}
+
+// Recorder is a Renderer recording the calls it gets instead of drawing, e.g. to test rendering
+type Recorder struct {
+	// Metrics is returned by FontMetrics, Text elements are skipped if it is nil
+	Metrics FontMetrics
+	Calls   []RenderCall
+}
+
+// NewRecorder constructs a new Recorder laying out text with fm
+func NewRecorder(fm FontMetrics) *Recorder {
+	return &Recorder{Metrics: fm}
+}
+
+// FontMetrics returns the Metrics of the Recorder
+func (rec *Recorder) FontMetrics() FontMetrics {
+	return rec.Metrics
+}
+
+// PushTransform records a call of PushTransform
+func (rec *Recorder) PushTransform(m Matrix) {
+	rec.Calls = append(rec.Calls, RenderCall{Op: OpPushTransform, Matrix: m})
+}
+
+// PopTransform records a call of PopTransform
+func (rec *Recorder) PopTransform() {
+	rec.Calls = append(rec.Calls, RenderCall{Op: OpPopTransform})
+}
+
+// SetPaint records a call of SetPaint
+func (rec *Recorder) SetPaint(ps PaintStyle) {
+	rec.Calls = append(rec.Calls, RenderCall{Op: OpSetPaint, Paint: ps})
+}
+
+// FillPath records a call of FillPath
+func (rec *Recorder) FillPath(pd PathData) {
+	rec.Calls = append(rec.Calls, RenderCall{Op: OpFillPath, Path: pd})
+}
+
+// StrokePath records a call of StrokePath
+func (rec *Recorder) StrokePath(pd PathData) {
+	rec.Calls = append(rec.Calls, RenderCall{Op: OpStrokePath, Path: pd})
+}
+
+// DrawText records a call of DrawText
+func (rec *Recorder) DrawText(x, y float64, text string, anchor TextAnchor) {
+	rec.Calls = append(rec.Calls, RenderCall{Op: OpDrawText, X: x, Y: y, Text: text, Anchor: anchor})
+}
+
+// Clip records a call of Clip
+func (rec *Recorder) Clip(pd PathData, rule FillRule) {
+	rec.Calls = append(rec.Calls, RenderCall{Op: OpClip, Path: pd, FillRule: rule})
+}
+
+// PushLayer records a call of PushLayer
+func (rec *Recorder) PushLayer(opacity float64) {
+	rec.Calls = append(rec.Calls, RenderCall{Op: OpPushLayer, Opacity: opacity})
+}
+
+// PopLayer records a call of PopLayer
+func (rec *Recorder) PopLayer() {
+	rec.Calls = append(rec.Calls, RenderCall{Op: OpPopLayer})
+}
+
+// Reset removes the recorded calls
+func (rec *Recorder) Reset() {
+	rec.Calls = nil
+}
+
+// String returns the recorded calls formatted one per line
+func (rec *Recorder) String() string {
+	lines := make([]string, 0, len(rec.Calls))
+	for _, c := range rec.Calls {
+		lines = append(lines, c.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
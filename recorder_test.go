@@ -0,0 +1,56 @@
+package svg
+
+import (
+	"testing"
+)
+
+func TestRenderCall_String(t *testing.T) {
+	red := Red.ToColor()
+	translucent := Blue.ToColor()
+	translucent.A = 128
+
+	tests := []struct {
+		name string
+		rc   RenderCall
+		want string
+	}{
+		{"push", RenderCall{Op: OpPushTransform, Matrix: TranslateMatrix(1, 2)}, "push matrix(1 0 0 1 1 2)"},
+		{"pop", RenderCall{Op: OpPopTransform}, "pop"},
+		{"fill paint", RenderCall{Op: OpSetPaint, Paint: PaintStyle{Fill: &red}}, "paint fill #ff0000 stroke none"},
+		{"stroke paint", RenderCall{Op: OpSetPaint, Paint: PaintStyle{Stroke: &translucent, StrokeWidth: 2}}, "paint fill none stroke #0000ff80 width 2"},
+		{"fill", RenderCall{Op: OpFillPath, Path: NewPathData().MoveTo(1, 2).LineTo(3, 4).ClosePath()}, "fill M1 2 L3 4 Z"},
+		{"stroke", RenderCall{Op: OpStrokePath, Path: NewPathData().MoveTo(1, 2).LineTo(3, 4)}, "stroke M1 2 L3 4"},
+		{"text", RenderCall{Op: OpDrawText, X: 1, Y: 2.5, Text: `a "b"`, Anchor: End}, `text 1 2.5 end "a \"b\""`},
+		{"clip", RenderCall{Op: OpClip, Path: NewPathData().MoveTo(0, 0).LineTo(1, 0).LineTo(0, 1).ClosePath(), FillRule: FillRuleEvenOdd}, "clip evenodd M0 0 L1 0 L0 1 Z"},
+		{"layer", RenderCall{Op: OpPushLayer, Opacity: 0.5}, "layer 0.5"},
+		{"composite", RenderCall{Op: OpPopLayer}, "composite"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rc.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	rec := NewRecorder(nil)
+
+	rec.PushTransform(ScaleMatrix(2, 2))
+	rec.Clip(NewPathData().MoveTo(0, 0).LineTo(1, 0).LineTo(0, 1).ClosePath(), FillRuleNonZero)
+	rec.SetPaint(PaintStyle{Fill: &black})
+	rec.FillPath(NewPathData().MoveTo(0, 0).LineTo(1, 1))
+	rec.DrawText(1, 2, "a", Start)
+	rec.PopTransform()
+
+	want := "push matrix(2 0 0 2 0 0)\nclip nonzero M0 0 L1 0 L0 1 Z\npaint fill #000000 stroke none\nfill M0 0 L1 1\ntext 1 2 start \"a\"\npop"
+	if got := rec.String(); got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+
+	rec.Reset()
+	if len(rec.Calls) != 0 {
+		t.Errorf("Reset() calls = %v, want none", rec.Calls)
+	}
+}
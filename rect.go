@@ -8,22 +8,18 @@ import (
 // Rect represents a Rect SVG element
 // See: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/rect
 type Rect struct {
-	XMLName       xml.Name
-	X             *Length    `xml:"x,attr,omitempty"`
-	Y             *Length    `xml:"y,attr,omitempty"`
-	Width         *Length    `xml:"width,attr,omitempty"`
-	Height        *Length    `xml:"height,attr,omitempty"`
-	RX            *Length    `xml:"rx,attr,omitempty"`
-	RY            *Length    `xml:"ry,attr,omitempty"`
-	Stroke        *Color     `xml:"stroke,attr,omitempty"`
-	StrokeWidth   *uint8     `xml:"stroke-width,attr,omitempty"`
-	StrokeOpacity *Opacity   `xml:"stroke-opacity,attr,omitempty"`
-	Fill          *Color     `xml:"fill,attr,omitempty"`
-	FillOpacity   *Opacity   `xml:"fill-opacity,attr,omitempty"`
-	Opacity       float64    `xml:"opacity,attr,omitempty"`
-	Attrs         []xml.Attr `xml:",attr"`
-	Children      []interface{}
-	lock          *sync.Mutex
+	XMLName   xml.Name
+	X         *LengthExpr `xml:"x,attr,omitempty"`
+	Y         *LengthExpr `xml:"y,attr,omitempty"`
+	Width     *LengthExpr `xml:"width,attr,omitempty"`
+	Height    *LengthExpr `xml:"height,attr,omitempty"`
+	RX        *LengthExpr `xml:"rx,attr,omitempty"`
+	RY        *LengthExpr `xml:"ry,attr,omitempty"`
+	Transform *Transform  `xml:"transform,attr,omitempty"`
+	Presentation
+	Attrs    []xml.Attr `xml:",attr"`
+	Children []interface{}
+	lock     *sync.Mutex
 }
 
 // R constructs new Rect element (shortcut)
@@ -63,12 +59,12 @@ func R(x, y, width, height float64, children ...interface{}) Rect {
 func NewRect(x, y, width, height, rx, ry *Length, children ...interface{}) Rect {
 	c := Rect{
 		XMLName: xml.Name{Local: "rect"},
-		X:       x,
-		Y:       y,
-		Width:   width,
-		Height:  height,
-		RX:      rx,
-		RY:      ry,
+		X:       exprOf(x),
+		Y:       exprOf(y),
+		Width:   exprOf(width),
+		Height:  exprOf(height),
+		RX:      exprOf(rx),
+		RY:      exprOf(ry),
 		lock:    &sync.Mutex{},
 	}
 
@@ -77,85 +73,16 @@ func NewRect(x, y, width, height, rx, ry *Length, children ...interface{}) Rect
 	return c
 }
 
-// SetStrokeWidth sets the stroke width of a Rect
-func (r Rect) SetStrokeWidth(strokeWidth uint8) Rect {
-	r.StrokeWidth = &strokeWidth
+// SetTransform sets the transform of a Rect
+func (r Rect) SetTransform(transform Transform) Rect {
+	r.Transform = &transform
 
 	return r
 }
 
-// UnsetStrokeWidth removes the previously set stroke width of a Rect
-func (r Rect) UnsetStrokeWidth() Rect {
-	r.StrokeWidth = nil
-
-	return r
-}
-
-// SetStroke sets the stroke color of a Rect
-func (r Rect) SetStroke(stroke Color) Rect {
-	r.Stroke = &stroke
-
-	return r
-}
-
-// UnsetStroke removes the previously set stroke color of a Rect
-func (r Rect) UnsetStroke() Rect {
-	r.Stroke = nil
-
-	return r
-}
-
-// SetStrokeOpacity sets the stroke opacity of a Rect
-func (r Rect) SetStrokeOpacity(so Opacity) Rect {
-	r.StrokeOpacity = &so
-
-	return r
-}
-
-// SetStrokeOpacity removes the stroke opacity of a Rect
-func (r Rect) UnsetStrokeOpacity() Rect {
-	r.StrokeOpacity = nil
-
-	return r
-}
-
-// SetStroke sets the fill color of a Rect
-func (r Rect) SetFill(fill Color) Rect {
-	r.Fill = &fill
-
-	return r
-}
-
-// UnsetStroke removes the previously set fill color of a Rect
-func (r Rect) UnsetFill() Rect {
-	r.Fill = nil
-
-	return r
-}
-
-// SetStrokeOpacity sets the fill opacity of a Rect
-func (r Rect) SetFillOpacity(fo Opacity) Rect {
-	r.FillOpacity = &fo
-
-	return r
-}
-
-// SetStrokeOpacity removes the stroke opacity of a Rect
-func (r Rect) UnsetFillOpacity() Rect {
-	r.FillOpacity = nil
-
-	return r
-}
-
-// SetOpacity sets the opacity of a Rect
-func (r Rect) SetOpacity(o float64) Rect {
-	if o < 0 {
-		r.Opacity = 0
-	} else if o > 1 {
-		r.Opacity = 1
-	} else {
-		r.Opacity = o
-	}
+// UnsetTransform removes the previously set transform of a Rect
+func (r Rect) UnsetTransform() Rect {
+	r.Transform = nil
 
 	return r
 }
@@ -183,3 +110,14 @@ func (r Rect) RemoveAttr(name string) Rect {
 
 	return r
 }
+
+// BBox returns the bounding box of the fill area of a Rect, without its transform
+// Percentages are resolved against an empty viewport, use Geometry for other viewports.
+func (r Rect) BBox() BBox {
+	return defaultGeometry.BBox(r)
+}
+
+// StrokeBBox returns the bounding box of a Rect including its stroke, without its transform
+func (r Rect) StrokeBBox() BBox {
+	return defaultGeometry.StrokeBBox(r)
+}
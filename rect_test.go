@@ -24,7 +24,7 @@ func TestR(t *testing.T) {
 		{
 			"simple rect",
 			args{x: 1, y: 2, width: 4.2, height: 3.1},
-			Rect{XMLName: xml.Name{Local: "rect"}, X: &Length{Number: 1}, Y: &Length{Number: 2}, Width: &Length{Number: 4.2}, Height: &Length{Number: 3.1}, lock: &sync.Mutex{}},
+			Rect{XMLName: xml.Name{Local: "rect"}, X: exprOf(&Length{Number: 1}), Y: exprOf(&Length{Number: 2}), Width: exprOf(&Length{Number: 4.2}), Height: exprOf(&Length{Number: 3.1}), lock: &sync.Mutex{}},
 		},
 	}
 	for _, tt := range tests {
@@ -54,7 +54,7 @@ func TestNewRect(t *testing.T) {
 		{
 			"simple rect",
 			args{x: &Length{Number: 1}, y: &Length{Number: 2}, width: &Length{Number: 20}, height: &Length{Number: 10}, rx: &Length{Number: 4.2}, ry: &Length{Number: 3.1}},
-			Rect{XMLName: xml.Name{Local: "rect"}, X: &Length{Number: 1}, Y: &Length{Number: 2}, Width: &Length{Number: 20}, Height: &Length{Number: 10}, RX: &Length{Number: 4.2}, RY: &Length{Number: 3.1}, lock: &sync.Mutex{}},
+			Rect{XMLName: xml.Name{Local: "rect"}, X: exprOf(&Length{Number: 1}), Y: exprOf(&Length{Number: 2}), Width: exprOf(&Length{Number: 20}), Height: exprOf(&Length{Number: 10}), RX: exprOf(&Length{Number: 4.2}), RY: exprOf(&Length{Number: 3.1}), lock: &sync.Mutex{}},
 		},
 	}
 	for _, tt := range tests {
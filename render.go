@@ -0,0 +1,116 @@
+package svg
+
+// Renderer is an output format SVG trees are rendered to, e.g. a raster image or a PDF page
+// Render walks the tree and drives it, so every format renders the same elements and only has to know
+// how to draw. Coordinates are in the current user space, which is changed by PushTransform.
+type Renderer interface {
+	// FontMetrics returns the metrics text is laid out with, Text elements are skipped if it is nil
+	FontMetrics() FontMetrics
+	// PushTransform saves the transformation and the clipping region, then concatenates m to the
+	// transformation until the matching PopTransform
+	PushTransform(m Matrix)
+	// PopTransform restores the state saved by the matching PushTransform
+	PopTransform()
+	// SetPaint sets the style the following paths and texts are painted with
+	SetPaint(ps PaintStyle)
+	// FillPath fills a normalized PathData with the fill of the paint, using its fill rule
+	FillPath(pd PathData)
+	// StrokePath strokes a normalized PathData with the stroke of the paint
+	StrokePath(pd PathData)
+	// DrawText draws text with the fill of the paint, x and y is the point on the baseline anchor aligns
+	// the text to
+	DrawText(x, y float64, text string, anchor TextAnchor)
+	// Clip intersects the clipping region with the area of a normalized PathData
+	Clip(pd PathData, rule FillRule)
+	// PushLayer starts drawing into a new transparent layer, which is composited with opacity over what
+	// was drawn before at the matching PopLayer
+	PushLayer(opacity float64)
+	// PopLayer composites the layer started by the matching PushLayer
+	PopLayer()
+}
+
+// Render draws the children of an SVG tag with r
+// The current user space of r must be the viewport of the SVG, whose size is resolved with lr.
+// The descendants of a Group, Use or Symbol with an opacity are drawn into a layer composited with it.
+func Render(s SVG, r Renderer, lr LengthResolver) error {
+	vm, err := s.ViewportMatrix(lr)
+	if err != nil {
+		return err
+	}
+
+	gw := newGeometryWalker(s.userSpaceResolver(), true, s.Children...)
+	gw.fm = r.FontMetrics()
+	gw.enter, gw.leave, gw.clip = r.PushTransform, r.PopTransform, r.Clip
+	gw.layer, gw.composite = r.PushLayer, r.PopLayer
+
+	_, leave := gw.push(IdentityMatrix(), transformMatrix(s.Transform).Multiply(vm))
+	defer leave()
+
+	gw.visitAll(s.Children, IdentityMatrix(), func(leaf interface{}, _ Matrix) bool {
+		renderLeaf(gw, r, leaf)
+
+		return true
+	})
+
+	return nil
+}
+
+// renderLeaf draws a shape or a Text visited by gw with r
+// The opacity of an element applies to the element as a whole, so the overlap of its fill and stroke is
+// not darker than the rest. A shape both filled and stroked is drawn into a layer for this, otherwise
+// the opacity is applied to its only paint.
+func renderLeaf(gw *geometryWalker, r Renderer, leaf interface{}) {
+	if t, ok := leaf.(Text); ok {
+		p := gw.presentation(t)
+		if p.Visibility != nil && *p.Visibility != VisibilityVisible {
+			return
+		}
+
+		fill := fade(paintColor(p.Fill, &black, p.FillOpacity), elementOpacity(p.Opacity))
+
+		runs := layoutText(t, gw.lr, gw.fm)
+		if len(runs) == 0 || fill == nil {
+			return
+		}
+
+		r.SetPaint(PaintStyle{Fill: fill})
+		for _, run := range runs {
+			r.DrawText(run.anchorX(), run.Y, run.Text, run.Anchor)
+		}
+
+		return
+	}
+
+	sg, ok := gw.geometry(leaf)
+	if !ok {
+		return
+	}
+
+	ps, ok := gw.paintStyle(leaf, sg)
+	if !ok {
+		return
+	}
+
+	opacity := elementOpacity(sg.Opacity)
+	switch {
+	case opacity == 1:
+	case opacity == 0:
+		return
+	case ps.Fill != nil && ps.Stroke != nil:
+		r.PushLayer(opacity)
+		defer r.PopLayer()
+	default:
+		ps.Fill, ps.Stroke = fade(ps.Fill, opacity), fade(ps.Stroke, opacity)
+		if ps.Fill == nil && ps.Stroke == nil {
+			return
+		}
+	}
+
+	r.SetPaint(ps)
+	if ps.Fill != nil {
+		r.FillPath(sg.Outline)
+	}
+	if ps.Stroke != nil {
+		r.StrokePath(sg.Outline)
+	}
+}
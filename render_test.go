@@ -0,0 +1,187 @@
+package svg
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	none := DisplayNone
+	fm := FixedFontMetrics{FontSize: 10, CharWidth: 0.5, Ascent: 0.8, Descent: 0.2}
+
+	tests := []struct {
+		name string
+		s    SVG
+		want []string
+	}{
+		{
+			"shape",
+			NewSVG(10, 10, R(1, 2, 3, 4)),
+			[]string{"paint fill #000000 stroke none", "fill M1 2 L4 2 L4 6 L1 6 Z"},
+		},
+		{
+			"viewBox and transforms",
+			NewSVG(20, 20,
+				NewGroup(
+					L(0, 0, 1, 1).SetStroke(Red.ToColor()).SetTransform(NewTransform().Scale(2, 2)),
+				).SetTransform(NewTransform().Translate(1, 2)),
+			).SetViewBox(VB(0, 0, 10, 10)),
+			[]string{
+				"push matrix(2 0 0 2 0 0)",
+				"push matrix(1 0 0 1 1 2)",
+				"push matrix(2 0 0 2 0 0)",
+				"paint fill #000000 stroke #ff0000 width 1",
+				"fill M0 0 L1 1",
+				"stroke M0 0 L1 1",
+				"pop",
+				"pop",
+				"pop",
+			},
+		},
+		{
+			"use",
+			NewSVG(10, 10, NewDefs(R(0, 0, 1, 1).SetID("dot").SetFillPaint(NonePaint()).SetStroke(Blue.ToColor())), U("#dot", 3, 0)),
+			[]string{
+				"push matrix(1 0 0 1 3 0)",
+				"paint fill none stroke #0000ff width 1",
+				"stroke M0 0 L1 0 L1 1 L0 1 Z",
+				"pop",
+			},
+		},
+		{
+			"use of symbol",
+			NewSVG(10, 10, NewDefs(NewSymbol("icon", R(0, 0, 1, 1)).SetViewBox(VB(0, 0, 10, 10))), U("#icon", 1, 2).SetWidth(Lth(20)).SetHeight(Lth(20))),
+			[]string{
+				"push matrix(1 0 0 1 1 2)",
+				"push matrix(1 0 0 1 0 0)",
+				"clip nonzero M0 0 L20 0 L20 20 L0 20 Z",
+				"push matrix(2 0 0 2 0 0)",
+				"paint fill #000000 stroke none",
+				"fill M0 0 L1 0 L1 1 L0 1 Z",
+				"pop",
+				"pop",
+				"pop",
+			},
+		},
+		{
+			"hidden",
+			NewSVG(10, 10, NewGroup(R(1, 2, 3, 4)).SetTransform(NewTransform().Translate(1, 2)).SetDisplay(none)),
+			nil,
+		},
+		{
+			"text",
+			NewSVG(10, 10, T(1, 2, CharData("ab")), T(1, 2)),
+			[]string{"paint fill #000000 stroke none", `text 1 2 start "ab"`},
+		},
+		{
+			"text anchor",
+			NewSVG(10, 10, T(10, 2, CharData("ab")).SetTextAnchor(Middle).SetFill(Red.ToColor())),
+			[]string{"paint fill #ff0000 stroke none", `text 10 2 middle "ab"`},
+		},
+		{
+			"text opacity",
+			NewSVG(10, 10, T(1, 2, CharData("ab")).SetOpacity(0.5)),
+			[]string{"paint fill #00000080 stroke none", `text 1 2 start "ab"`},
+		},
+		{
+			"opacity of a filled shape",
+			NewSVG(10, 10, R(1, 2, 3, 4).SetFillOpacity(O(0.5)).SetOpacity(0.5)),
+			[]string{"paint fill #00000040 stroke none", "fill M1 2 L4 2 L4 6 L1 6 Z"},
+		},
+		{
+			"opacity of a filled and stroked shape",
+			NewSVG(10, 10, R(1, 2, 3, 4).SetStroke(Red.ToColor()).SetOpacity(0.5)),
+			[]string{
+				"layer 0.5",
+				"paint fill #000000 stroke #ff0000 width 1",
+				"fill M1 2 L4 2 L4 6 L1 6 Z",
+				"stroke M1 2 L4 2 L4 6 L1 6 Z",
+				"composite",
+			},
+		},
+		{
+			"group opacity",
+			NewSVG(10, 10, NewGroup(R(1, 2, 3, 4), R(2, 3, 3, 4).SetOpacity(0.5)).SetTransform(NewTransform().Translate(1, 2)).SetOpacity(0.5)),
+			[]string{
+				"push matrix(1 0 0 1 1 2)",
+				"layer 0.5",
+				"paint fill #000000 stroke none",
+				"fill M1 2 L4 2 L4 6 L1 6 Z",
+				"paint fill #00000080 stroke none",
+				"fill M2 3 L5 3 L5 7 L2 7 Z",
+				"composite",
+				"pop",
+			},
+		},
+		{
+			"opacity of use and symbol",
+			NewSVG(10, 10, NewDefs(NewSymbol("icon", R(0, 0, 1, 1)).SetOpacity(0.5)), U("#icon", 1, 2).SetOpacity(0.25)),
+			[]string{
+				"layer 0.25",
+				"push matrix(1 0 0 1 1 2)",
+				"layer 0.5",
+				"paint fill #000000 stroke none",
+				"fill M0 0 L1 0 L1 1 L0 1 Z",
+				"composite",
+				"pop",
+				"composite",
+			},
+		},
+		{
+			"transparent",
+			NewSVG(10, 10,
+				R(1, 2, 3, 4).SetStroke(Red.ToColor()).SetOpacity(0),
+				NewGroup(R(1, 2, 3, 4)).SetOpacity(0),
+				U("#dot", 1, 2).SetOpacity(0),
+				NewDefs(NewSymbol("dot", R(0, 0, 1, 1)).SetOpacity(0)),
+				U("#dot", 1, 2),
+			),
+			[]string{"push matrix(1 0 0 1 1 2)", "pop"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := NewRecorder(fm)
+			if err := Render(tt.s, rec, NewLengthResolver(0, 0)); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+
+			var got []string
+			for _, c := range rec.Calls {
+				got = append(got, c.String())
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Render() calls = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRender_transparent(t *testing.T) {
+	s, err := ParseSVG(strings.NewReader(`<svg xmlns="http://www.w3.org/2000/svg" width="10" height="10">
+	<rect width="5" height="5" opacity="0"/>
+	<g opacity="0"><rect width="5" height="5"/></g>
+	<text x="1" y="2" opacity="0">ab</text>
+</svg>`))
+	if err != nil {
+		t.Fatalf("ParseSVG() error = %v", err)
+	}
+
+	rec := NewRecorder(FixedFontMetrics{FontSize: 10, CharWidth: 0.5, Ascent: 0.8, Descent: 0.2})
+	if err := Render(s, rec, NewLengthResolver(0, 0)); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if len(rec.Calls) != 0 {
+		t.Errorf("Render() calls = %v, want none", rec.Calls)
+	}
+}
+
+func TestRender_invalidViewBox(t *testing.T) {
+	s := NewSVG(10, 10, R(1, 2, 3, 4)).SetViewBox(VB(0, 0, 0, 10))
+
+	if err := Render(s, NewRecorder(nil), NewLengthResolver(0, 0)); err == nil {
+		t.Errorf("Render() error = %v, wantErr %v", err, true)
+	}
+}
@@ -2,24 +2,41 @@ package svg
 
 import (
 	"encoding/xml"
+	"fmt"
+	"reflect"
 	"sync"
 )
 
 type SVG struct {
-	XMLName  xml.Name
-	Width    float64    `xml:"width,attr,omitempty"`
-	Height   float64    `xml:"height,attr,omitempty"`
-	Version  string     `xml:"version,attr,omitempty"`
-	Attrs    []xml.Attr `xml:",attr"`
-	Children []interface{}
-	lock     *sync.Mutex
+	XMLName             xml.Name
+	Width               *LengthExpr          `xml:"width,attr,omitempty"`
+	Height              *LengthExpr          `xml:"height,attr,omitempty"`
+	Version             string               `xml:"version,attr,omitempty"`
+	Transform           *Transform           `xml:"transform,attr,omitempty"`
+	ViewBox             *ViewBox             `xml:"viewBox,attr,omitempty"`
+	PreserveAspectRatio *PreserveAspectRatio `xml:"preserveAspectRatio,attr,omitempty"`
+	Attrs               []xml.Attr           `xml:",attr"`
+	Children            []interface{}
+	lock                *sync.Mutex
 }
 
+// NewSVG constructs new SVG element, width and height are in user units and left out if they are 0
+// Use SetWidth and SetHeight for other units, e.g. 100% or 210mm.
 func NewSVG(width, height float64, children ...interface{}) SVG {
+	var pWidth, pHeight *Length
+
+	if width != 0.0 {
+		pWidth = &Length{Number: width}
+	}
+
+	if height != 0.0 {
+		pHeight = &Length{Number: height}
+	}
+
 	s := SVG{
 		XMLName: xml.Name{Space: "http://www.w3.org/2000/svg", Local: "svg"},
-		Width:   width,
-		Height:  height,
+		Width:   exprOf(pWidth),
+		Height:  exprOf(pHeight),
 		Version: "1.1",
 		lock:    &sync.Mutex{},
 	}
@@ -29,6 +46,172 @@ func NewSVG(width, height float64, children ...interface{}) SVG {
 	return s
 }
 
+// SetWidth sets the width of an SVG tag
+func (s SVG) SetWidth(width Length) SVG {
+	s.Width = exprOf(&width)
+
+	return s
+}
+
+// UnsetWidth removes the previously set width of an SVG tag
+func (s SVG) UnsetWidth() SVG {
+	s.Width = nil
+
+	return s
+}
+
+// SetHeight sets the height of an SVG tag
+func (s SVG) SetHeight(height Length) SVG {
+	s.Height = exprOf(&height)
+
+	return s
+}
+
+// UnsetHeight removes the previously set height of an SVG tag
+func (s SVG) UnsetHeight() SVG {
+	s.Height = nil
+
+	return s
+}
+
+// SetViewBox sets the viewBox of an SVG tag
+func (s SVG) SetViewBox(viewBox ViewBox) SVG {
+	s.ViewBox = &viewBox
+
+	return s
+}
+
+// UnsetViewBox removes the previously set viewBox of an SVG tag
+func (s SVG) UnsetViewBox() SVG {
+	s.ViewBox = nil
+
+	return s
+}
+
+// SetPreserveAspectRatio sets the preserveAspectRatio attribute of an SVG tag
+func (s SVG) SetPreserveAspectRatio(par PreserveAspectRatio) SVG {
+	s.PreserveAspectRatio = &par
+
+	return s
+}
+
+// UnsetPreserveAspectRatio removes the previously set preserveAspectRatio attribute of an SVG tag
+func (s SVG) UnsetPreserveAspectRatio() SVG {
+	s.PreserveAspectRatio = nil
+
+	return s
+}
+
+// ViewportSize returns the size of the viewport of an SVG tag in user units
+// Lengths are resolved with lr, which describes the viewport the SVG is embedded into,
+// missing width and height default to 100%.
+func (s SVG) ViewportSize(lr LengthResolver) (float64, float64) {
+	width, height := Lth(100, Percent).Expr(), Lth(100, Percent).Expr()
+	if s.Width != nil {
+		width = *s.Width
+	}
+	if s.Height != nil {
+		height = *s.Height
+	}
+
+	return lr.ResolveExpr(width, AxisX), lr.ResolveExpr(height, AxisY)
+}
+
+// intrinsicSize returns the size of the viewport of an SVG tag in user units when it is not embedded
+// into anything, sizes relative to the embedding viewport, e.g. 100%, fall back to the size of the viewBox
+func (s SVG) intrinsicSize() (float64, float64) {
+	width, height := s.ViewportSize(NewLengthResolver(0, 0))
+	if (width <= 0 || height <= 0) && s.ViewBox != nil {
+		return s.ViewBox.Width, s.ViewBox.Height
+	}
+
+	return width, height
+}
+
+// ViewportMatrix returns the Matrix which maps the user space of an SVG tag into its viewport
+// Without a viewBox the user space is the viewport itself, so the identity Matrix is returned.
+func (s SVG) ViewportMatrix(lr LengthResolver) (Matrix, error) {
+	if s.ViewBox == nil {
+		return IdentityMatrix(), nil
+	}
+
+	var par PreserveAspectRatio
+	if s.PreserveAspectRatio != nil {
+		par = *s.PreserveAspectRatio
+	}
+
+	width, height := s.ViewportSize(lr)
+
+	return s.ViewBox.ViewportMatrix(par, 0, 0, width, height)
+}
+
+// userSpaceResolver returns a LengthResolver for lengths in the user space of an SVG tag
+// Percentages refer to the viewBox if there is one, otherwise to the absolute width and height.
+func (s SVG) userSpaceResolver() LengthResolver {
+	if s.ViewBox != nil {
+		return NewLengthResolver(s.ViewBox.Width, s.ViewBox.Height)
+	}
+
+	return NewLengthResolver(s.ViewportSize(NewLengthResolver(0, 0)))
+}
+
+// ContentBBox returns the union bounding box of all children of an SVG tag in its user space
+// Transforms of the children and stroke widths are taken into account, Use elements are followed.
+// Text is not measured, as it depends on font metrics.
+func (s SVG) ContentBBox() BBox {
+	gw := newGeometryWalker(s.userSpaceResolver(), true, s.Children...)
+
+	return gw.bboxAll(s.Children, IdentityMatrix())
+}
+
+// FitViewBox sets the viewBox of an SVG tag to the bounding box of its children grown by margin
+// on each side, the width and height are set to the size of the viewBox in user units.
+// An SVG without any rendered children is returned unchanged.
+func (s SVG) FitViewBox(margin float64) SVG {
+	return s.FitViewBoxTo(margin, 0, 0)
+}
+
+// FitViewBoxTo sets the viewBox of an SVG tag to the bounding box of its children grown by margin
+// on each side and scales it to width and height in pixels
+// If either width or height is 0, it is calculated from the aspect ratio of the viewBox, if both
+// are 0, the size of the viewBox is used as is. An SVG without any rendered children is returned unchanged.
+func (s SVG) FitViewBoxTo(margin, width, height float64) SVG {
+	b := s.ContentBBox()
+	if b.IsEmpty() {
+		return s
+	}
+
+	b = b.Expand(margin, margin)
+
+	w, h := b.Width(), b.Height()
+
+	switch {
+	case width > 0 && height > 0:
+	case width > 0 && w > 0:
+		height = width * h / w
+	case height > 0 && h > 0:
+		width = height * w / h
+	default:
+		width, height = w, h
+	}
+
+	return s.SetViewBox(b.ViewBox()).SetWidth(Lth(width)).SetHeight(Lth(height))
+}
+
+// SetTransform sets the transform of an SVG tag
+func (s SVG) SetTransform(transform Transform) SVG {
+	s.Transform = &transform
+
+	return s
+}
+
+// UnsetTransform removes the previously set transform of an SVG tag
+func (s SVG) UnsetTransform() SVG {
+	s.Transform = nil
+
+	return s
+}
+
 // AddAttr adds a new attribute of an SVG tag
 func (s SVG) AddAttr(name, value string) SVG {
 	s.lock.Lock()
@@ -52,3 +235,89 @@ func (s SVG) RemoveAttr(name string) SVG {
 
 	return s
 }
+
+// Define registers children as a reusable definition of an SVG and returns a factory for Use elements referencing it
+// The definition is stored as a Group inside the first Defs child of the SVG, which is created if necessary.
+// If id is already taken by any element of the SVG or of children, a numeric suffix is added to make it unique.
+func (s SVG) Define(id string, children ...interface{}) (SVG, UseFactory) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// copy the children so that other copies of the SVG are not modified
+	newChildren := make([]interface{}, 0, len(s.Children)+1)
+
+	defsIdx := -1
+	for i, child := range s.Children {
+		if _, ok := child.(Defs); ok && defsIdx < 0 {
+			defsIdx = i
+		}
+		newChildren = append(newChildren, child)
+	}
+
+	if defsIdx < 0 {
+		newChildren = append([]interface{}{NewDefs()}, newChildren...)
+		defsIdx = 0
+	}
+
+	defs := newChildren[defsIdx].(Defs)
+
+	// the id must not collide with any element of the document, including the defined ones
+	taken := map[string]bool{}
+	collectIDs(taken, s.Children)
+	collectIDs(taken, children)
+
+	uniqueID := id
+	for i := 2; taken[uniqueID]; i++ {
+		uniqueID = fmt.Sprintf("%s-%d", id, i)
+	}
+
+	g := NewGroup(children...).SetID(uniqueID)
+
+	defs.Children = append(defs.Children[:len(defs.Children):len(defs.Children)], g)
+	newChildren[defsIdx] = defs
+	s.Children = newChildren
+
+	return s, UseFactory{ID: uniqueID}
+}
+
+// collectIDs marks the ids of elements and all of their descendants as taken
+func collectIDs(taken map[string]bool, elements []interface{}) {
+	for _, e := range elements {
+		if id := elementID(e); id != "" {
+			taken[id] = true
+		}
+
+		collectIDs(taken, elementChildren(e))
+	}
+}
+
+// elementID returns the id of an element if it has one
+func elementID(element interface{}) string {
+	v := reflect.ValueOf(element)
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	if f := v.FieldByName("ID"); f.IsValid() && f.Kind() == reflect.String {
+		return f.String()
+	}
+
+	for _, attr := range elementAttrs(v) {
+		if attr.Name.Local == "id" {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
+func elementAttrs(v reflect.Value) []xml.Attr {
+	f := v.FieldByName("Attrs")
+	if !f.IsValid() {
+		return nil
+	}
+
+	attrs, _ := f.Interface().([]xml.Attr)
+
+	return attrs
+}
@@ -0,0 +1,192 @@
+package svg
+
+import (
+	"fmt"
+)
+
+// Flatten renders an SVG tag into a new one of the same size consisting of groups, paths and texts only
+// Shapes become paths, Use references are replaced by the elements they refer to and inherited styles
+// are resolved, e.g. for programs which only understand paths. The viewBox is replaced by a transform.
+func Flatten(s SVG) (SVG, error) {
+	width, height := s.intrinsicSize()
+
+	sr := NewSVGRenderer(NewFixedFontMetrics(DefaultFontSize))
+	if err := Render(s, sr, NewLengthResolver(width, height)); err != nil {
+		return SVG{}, err
+	}
+
+	return NewSVG(width, height, sr.Children()...), nil
+}
+
+// SVGRenderer is a Renderer building a tree of SVG elements
+// Transforms and layers become groups, paths become Path elements and clipping regions become clipPath
+// elements, which are collected in a Defs element.
+type SVGRenderer struct {
+	fm FontMetrics
+	// frames holds the groups being built, the first one is the root
+	frames []svgFrame
+	defs   []interface{}
+	paint  PaintStyle
+}
+
+// svgFrame is a group being built, clip is set for the groups opened by Clip, which PopTransform and
+// PopLayer close together with the group of the matching PushTransform or PushLayer
+type svgFrame struct {
+	group Group
+	clip  bool
+}
+
+// NewSVGRenderer constructs a new SVGRenderer laying out text with fm
+func NewSVGRenderer(fm FontMetrics) *SVGRenderer {
+	return &SVGRenderer{fm: fm, frames: []svgFrame{{group: NewGroup()}}}
+}
+
+// Children returns the elements built, the clipping paths they refer to come first in a Defs element
+func (sr *SVGRenderer) Children() []interface{} {
+	var children []interface{}
+	if len(sr.defs) > 0 {
+		children = append(children, NewDefs(sr.defs...))
+	}
+
+	return append(children, sr.frames[0].group.Children...)
+}
+
+// FontMetrics returns the metrics the SVGRenderer was constructed with
+func (sr *SVGRenderer) FontMetrics() FontMetrics {
+	return sr.fm
+}
+
+// PushTransform starts a new Group transformed by m
+func (sr *SVGRenderer) PushTransform(m Matrix) {
+	g := NewGroup()
+	if m != IdentityMatrix() {
+		g = g.SetTransform(m.ToTransform())
+	}
+
+	sr.frames = append(sr.frames, svgFrame{group: g})
+}
+
+// PopTransform ends the Group started by the matching PushTransform
+func (sr *SVGRenderer) PopTransform() {
+	sr.pop()
+}
+
+// PushLayer starts a new Group with the opacity of the layer
+func (sr *SVGRenderer) PushLayer(opacity float64) {
+	sr.frames = append(sr.frames, svgFrame{group: NewGroup().SetOpacity(opacity)})
+}
+
+// PopLayer ends the Group started by the matching PushLayer
+func (sr *SVGRenderer) PopLayer() {
+	sr.pop()
+}
+
+// pop ends the current group together with the groups opened by Clip within it
+func (sr *SVGRenderer) pop() {
+	for len(sr.frames) > 1 {
+		f := sr.frames[len(sr.frames)-1]
+		sr.frames = sr.frames[:len(sr.frames)-1]
+		sr.add(f.group)
+
+		if !f.clip {
+			return
+		}
+	}
+}
+
+func (sr *SVGRenderer) add(element interface{}) {
+	g := &sr.frames[len(sr.frames)-1].group
+	g.Children = append(g.Children, element)
+}
+
+// SetPaint sets the style of the following elements
+func (sr *SVGRenderer) SetPaint(ps PaintStyle) {
+	sr.paint = ps
+}
+
+// FillPath adds a filled Path
+func (sr *SVGRenderer) FillPath(pd PathData) {
+	ps := sr.paint
+	if ps.Fill == nil {
+		return
+	}
+
+	p := NewPath(nil).SetD(pd).SetFill(ps.Fill.Opaque())
+	if ps.Fill.A < 255 {
+		p = p.SetFillOpacity(ps.Fill.Opacity())
+	}
+	if ps.FillRule == FillRuleEvenOdd {
+		p = p.SetFillRule(FillRuleEvenOdd)
+	}
+
+	sr.add(p)
+}
+
+// StrokePath adds a stroked Path
+func (sr *SVGRenderer) StrokePath(pd PathData) {
+	ps := sr.paint
+	if ps.Stroke == nil {
+		return
+	}
+
+	p := NewPath(nil).SetD(pd).SetFillPaint(NonePaint()).SetStroke(ps.Stroke.Opaque())
+	if ps.Stroke.A < 255 {
+		p = p.SetStrokeOpacity(ps.Stroke.Opacity())
+	}
+	if ps.StrokeWidth != 1 {
+		p = p.SStrokeWidth(ps.StrokeWidth)
+	}
+	if ps.Linecap != LinecapButt {
+		p = p.SetStrokeLinecap(ps.Linecap)
+	}
+	if ps.Linejoin != LinejoinMiter {
+		p = p.SetStrokeLinejoin(ps.Linejoin)
+	}
+	if ps.Miterlimit != 4 {
+		p = p.SetStrokeMiterlimit(ps.Miterlimit)
+	}
+	if len(ps.Dashes) > 0 {
+		dashes := make([]Length, 0, len(ps.Dashes))
+		for _, d := range ps.Dashes {
+			dashes = append(dashes, Lth(d))
+		}
+
+		p = p.SetStrokeDashArray(dashes...)
+		if ps.DashOffset != 0 {
+			p = p.SetStrokeDashOffset(Lth(ps.DashOffset))
+		}
+	}
+
+	sr.add(p)
+}
+
+// DrawText adds a Text
+func (sr *SVGRenderer) DrawText(x, y float64, text string, anchor TextAnchor) {
+	c := sr.paint.Fill
+	if c == nil {
+		return
+	}
+
+	t := T(x, y, CharData(text)).AddAttr("fill", c.Opaque().Format(ColorHex))
+	if c.A < 255 {
+		t = t.AddAttr("fill-opacity", fmt.Sprint(c.Opacity().Number))
+	}
+	if anchor != Start {
+		t = t.SetTextAnchor(anchor)
+	}
+
+	sr.add(t)
+}
+
+// Clip starts a new Group clipped by a clipPath element of pd
+func (sr *SVGRenderer) Clip(pd PathData, rule FillRule) {
+	id := fmt.Sprintf("clip%d", len(sr.defs)+1)
+
+	p := NewPath(nil).SetD(pd)
+	if rule == FillRuleEvenOdd {
+		p = p.AddAttr("clip-rule", string(FillRuleEvenOdd))
+	}
+
+	sr.defs = append(sr.defs, E("clipPath", "", "", map[string]string{"id": id}, p))
+	sr.frames = append(sr.frames, svgFrame{group: NewGroup().AddAttr("clip-path", "url(#"+id+")"), clip: true})
+}
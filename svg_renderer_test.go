@@ -0,0 +1,83 @@
+package svg
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	red := Red.ToColor()
+
+	tests := []struct {
+		name string
+		s    SVG
+		want []string
+	}{
+		{
+			"size",
+			NewSVG(20, 10),
+			[]string{`width="20" height="10"`},
+		},
+		{
+			"shape",
+			NewSVG(20, 20, R(1, 2, 3, 4).SetFill(red).SetFillOpacity(O(0.5)).SetFillRule(FillRuleEvenOdd)),
+			[]string{`<path d="M1 2 L4 2 L4 6 L1 6 Z" fill="#ff0000" fill-opacity="0.502" fill-rule="evenodd"></path>`},
+		},
+		{
+			"stroke",
+			NewSVG(20, 20, L(1, 2, 3, 4).SetStroke(red).SetStrokeWidth(Lth(2)).SetStrokeLinecap(LinecapRound).SetStrokeDashArray(Lth(1))),
+			[]string{`<path d="M1 2 L3 4" stroke-width="2" stroke="#ff0000" fill="none" stroke-dasharray="1 1" stroke-linecap="round"></path>`},
+		},
+		{
+			"opacity of fill and stroke",
+			NewSVG(20, 20, R(1, 2, 3, 4).SetFill(red).SetStroke(Blue.ToColor()).SetOpacity(0.5)),
+			[]string{`<g opacity="0.5"><path d="M1 2 L4 2 L4 6 L1 6 Z" fill="#ff0000"></path><path d="M1 2 L4 2 L4 6 L1 6 Z" stroke="#0000ff" fill="none"></path></g>`},
+		},
+		{
+			"viewBox",
+			NewSVG(20, 20, R(0, 0, 1, 1)).SetViewBox(VB(0, 0, 10, 10)),
+			[]string{`<g transform="matrix(2 0 0 2 0 0)"`, `<path d="M0 0 L1 0 L1 1 L0 1 Z" fill="#000000"></path></g>`},
+		},
+		{
+			"use of symbol",
+			NewSVG(20, 20, NewDefs(NewSymbol("icon", R(0, 0, 1, 1)).SetViewBox(VB(0, 0, 10, 10))), U("#icon", 1, 2).SetWidth(Lth(20)).SetHeight(Lth(20))),
+			[]string{
+				`<clipPath id="clip1"><path d="M0 0 L20 0 L20 20 L0 20 Z"></path></clipPath>`,
+				`<g transform="matrix(1 0 0 1 1 2)"><g><g clip-path="url(#clip1)"><g transform="matrix(2 0 0 2 0 0)"><path d="M0 0 L1 0 L1 1 L0 1 Z" fill="#000000"></path></g></g></g></g>`,
+			},
+		},
+		{
+			"text",
+			NewSVG(20, 20, T(10, 2, CharData("a<b")).SetTextAnchor(Middle)),
+			[]string{`<text x="10" y="2" text-anchor="middle" fill="#000000">a&lt;b</text>`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := Flatten(tt.s)
+			if err != nil {
+				t.Fatalf("Flatten() error = %v", err)
+			}
+
+			b, err := xml.Marshal(f)
+			if err != nil {
+				t.Fatalf("xml.Marshal() error = %v", err)
+			}
+
+			// newer versions of encoding/xml reset the namespace of children
+			got := strings.ReplaceAll(string(b), ` xmlns=""`, "")
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("Flatten() = %s, want to contain %s", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestFlatten_invalidViewBox(t *testing.T) {
+	if _, err := Flatten(NewSVG(10, 10).SetViewBox(VB(0, 0, 0, 10))); err == nil {
+		t.Errorf("Flatten() error = %v, wantErr %v", err, true)
+	}
+}
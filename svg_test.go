@@ -23,7 +23,7 @@ func TestNewSVG(t *testing.T) {
 		{
 			"simple svg",
 			args{width: 200, height: 50},
-			SVG{XMLName: xml.Name{Space: "http://www.w3.org/2000/svg", Local: "svg"}, Width: 200, Height: 50, Version: "1.1", lock: &sync.Mutex{}},
+			SVG{XMLName: xml.Name{Space: "http://www.w3.org/2000/svg", Local: "svg"}, Width: exprOf(&Length{Number: 200}), Height: exprOf(&Length{Number: 50}), Version: "1.1", lock: &sync.Mutex{}},
 		},
 	}
 	for _, tt := range tests {
@@ -51,15 +51,21 @@ func TestSVG_MarshalText(t *testing.T) {
 			[]string{`<svg xmlns="http://www.w3.org/2000/svg" width="200" height="100" version="1.1"></svg>`},
 			false,
 		},
+		{
+			"svg without size",
+			NewSVG(0, 0).SetViewBox(VB(0, 0, 40, 20)),
+			[]string{`<svg xmlns="http://www.w3.org/2000/svg" version="1.1" viewBox="0 0 40 20"></svg>`},
+			false,
+		},
 		{
 			"complex svg",
 			NewSVG(
 				200,
 				100,
-				L(0, 30, 170, 30).SetStroke(red).SetStrokeWidth(2),
-				L(170, 30, 170, 70).SetStroke(red).SetStrokeWidth(2),
-				L(170, 70, 30, 70).SetStroke(navy).SetStrokeWidth(2),
-				L(30, 70, 30, 30).SetStroke(red).SetStrokeWidth(2),
+				L(0, 30, 170, 30).SetStroke(red).SStrokeWidth(2),
+				L(170, 30, 170, 70).SetStroke(red).SStrokeWidth(2),
+				L(170, 70, 30, 70).SetStroke(navy).SStrokeWidth(2),
+				L(30, 70, 30, 30).SetStroke(red).SStrokeWidth(2),
 				T(0, 40, TS("foo")).SetTextAnchor(Middle).SetFill(red),
 				T(30, 40, TS("bar").SX(30)).SetTextAnchor(Start).SetFill(navy),
 			),
@@ -69,8 +75,8 @@ func TestSVG_MarshalText(t *testing.T) {
 				`<line x1="170" y1="30" x2="170" y2="70" stroke-width="2" stroke="#ff0000"></line>`,
 				`<line x1="170" y1="70" x2="30" y2="70" stroke-width="2" stroke="#000080"></line>`,
 				`<line x1="30" y1="70" x2="30" y2="30" stroke-width="2" stroke="#ff0000"></line>`,
-				`<text y="40" text-anchor="middle" stroke="#ff0000"><tspan>foo</tspan></text>`,
-				`<text x="30" y="40" text-anchor="start" stroke="#000080"><tspan x="30">bar</tspan></text>`,
+				`<text y="40" text-anchor="middle" fill="#ff0000"><tspan>foo</tspan></text>`,
+				`<text x="30" y="40" text-anchor="start" fill="#000080"><tspan x="30">bar</tspan></text>`,
 				`</svg>`,
 			},
 			false,
@@ -167,3 +173,101 @@ func TestSVG_RemoveAttr(t *testing.T) {
 		})
 	}
 }
+
+func TestSVG_Define(t *testing.T) {
+	s := NewSVG(100, 100)
+
+	s, dot := s.Define("dot", C(0, 0, 1))
+	s, dot2 := s.Define("dot", R(0, 0, 2, 2))
+
+	if dot.ID != "dot" {
+		t.Errorf("Define() id = %q, want %q", dot.ID, "dot")
+	}
+	if dot2.ID != "dot-2" {
+		t.Errorf("Define() id = %q, want %q", dot2.ID, "dot-2")
+	}
+
+	if len(s.Children) != 1 {
+		t.Fatalf("len(Children) = %d, want 1", len(s.Children))
+	}
+
+	gotBytes, err := xml.Marshal(s.Children[0])
+	if err != nil {
+		t.Fatalf("xml.Marshal() error = %v", err)
+	}
+
+	want := `<defs><g id="dot"><circle r="1"></circle></g><g id="dot-2"><rect width="2" height="2"></rect></g></defs>`
+	if got := string(gotBytes); got != want {
+		t.Errorf("xml.Marshal() got = %v, want %v", got, want)
+	}
+}
+
+func TestSVG_Define_ExistingDefs(t *testing.T) {
+	orig := NewSVG(10, 10, C(1, 1, 1), NewDefs(NewGroup().SetID("a")))
+
+	s, uf := orig.Define("a", C(1, 1, 1))
+
+	if uf.ID != "a-2" {
+		t.Errorf("Define() id = %q, want %q", uf.ID, "a-2")
+	}
+	if got := len(s.Children[1].(Defs).Children); got != 2 {
+		t.Errorf("defs has %d children, want 2", got)
+	}
+	if got := len(orig.Children[1].(Defs).Children); got != 1 {
+		t.Errorf("original defs has %d children, want 1", got)
+	}
+}
+
+func TestSVG_Define_Collisions(t *testing.T) {
+	tests := []struct {
+		name     string
+		svg      SVG
+		children []interface{}
+		want     string
+	}{
+		{"free", NewSVG(10, 10, C(1, 1, 1).SetID("b")), nil, "a"},
+		{"element outside of defs", NewSVG(10, 10, C(1, 1, 1).SetID("a")), nil, "a-2"},
+		{"nested element", NewSVG(10, 10, NewGroup(NewGroup(R(0, 0, 1, 1).SetID("a")))), nil, "a-2"},
+		{"element in a later defs", NewSVG(10, 10, NewDefs(), NewDefs(C(1, 1, 1).SetID("a"))), nil, "a-2"},
+		{"id attribute", NewSVG(10, 10, NewDefs(NewDefs().AddAttr("id", "a"))), nil, "a-2"},
+		{"defined child", NewSVG(10, 10), []interface{}{C(1, 1, 1).SetID("a")}, "a-2"},
+		{"suffixed id is taken too", NewSVG(10, 10, C(1, 1, 1).SetID("a"), C(1, 1, 1).SetID("a-2")), nil, "a-3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, uf := tt.svg.Define("a", tt.children...); uf.ID != tt.want {
+				t.Errorf("Define() id = %q, want %q", uf.ID, tt.want)
+			}
+		})
+	}
+}
+
+func TestSVG_FitViewBox(t *testing.T) {
+	s := NewSVG(0, 0,
+		C(10, 10, 5).SetStroke(Red.ToColor()).SStrokeWidth(2),
+		NewGroup(R(0, 0, 10, 10)).SetTransform(NewTransform().Translate(30, 0)),
+	)
+
+	got := s.FitViewBox(1)
+	if *got.ViewBox != VB(3, -1, 38, 18) {
+		t.Errorf("FitViewBox() viewBox = %v, want %v", got.ViewBox, VB(3, -1, 38, 18))
+	}
+	if got.Width.Length != Lth(38) || got.Height.Length != Lth(18) {
+		t.Errorf("FitViewBox() size = %v x %v, want 38 x 18", got.Width, got.Height)
+	}
+
+	got = s.FitViewBoxTo(1, 380, 0)
+	if *got.ViewBox != VB(3, -1, 38, 18) || got.Width.Length != Lth(380) || got.Height.Length != Lth(180) {
+		t.Errorf("FitViewBoxTo() = %v %v x %v", got.ViewBox, got.Width, got.Height)
+	}
+
+	got = s.FitViewBoxTo(0, 0, 32)
+	if got.Width.Length != Lth(72) || got.Height.Length != Lth(32) {
+		t.Errorf("FitViewBoxTo() size = %v x %v, want 72 x 32", got.Width, got.Height)
+	}
+
+	empty := NewSVG(10, 10, NewDefs(C(0, 0, 1)))
+	if got := empty.FitViewBox(1); got.ViewBox != nil || got.Width.Length != Lth(10) {
+		t.Errorf("FitViewBox() of empty content = %v %v", got.ViewBox, got.Width)
+	}
+}
@@ -0,0 +1,83 @@
+package svg
+
+import (
+	"encoding/xml"
+	"sync"
+)
+
+// Symbol represents a Symbol SVG element
+// See: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/symbol
+type Symbol struct {
+	XMLName             xml.Name
+	ViewBox             *ViewBox             `xml:"viewBox,attr,omitempty"`
+	PreserveAspectRatio *PreserveAspectRatio `xml:"preserveAspectRatio,attr,omitempty"`
+	Presentation
+	Attrs    []xml.Attr `xml:",attr"`
+	Children []interface{}
+	lock     *sync.Mutex
+}
+
+// NewSymbol constructs new Symbol element
+func NewSymbol(id string, children ...interface{}) Symbol {
+	s := Symbol{
+		XMLName: xml.Name{Local: "symbol"},
+		lock:    &sync.Mutex{},
+	}
+
+	s.ID = id
+	s.Children = append(s.Children, children...)
+
+	return s
+}
+
+// SetViewBox sets the viewBox of a Symbol
+func (s Symbol) SetViewBox(viewBox ViewBox) Symbol {
+	s.ViewBox = &viewBox
+
+	return s
+}
+
+// UnsetViewBox removes the previously set viewBox of a Symbol
+func (s Symbol) UnsetViewBox() Symbol {
+	s.ViewBox = nil
+
+	return s
+}
+
+// SetPreserveAspectRatio sets the preserveAspectRatio attribute of a Symbol
+func (s Symbol) SetPreserveAspectRatio(par PreserveAspectRatio) Symbol {
+	s.PreserveAspectRatio = &par
+
+	return s
+}
+
+// UnsetPreserveAspectRatio removes the previously set preserveAspectRatio attribute of a Symbol
+func (s Symbol) UnsetPreserveAspectRatio() Symbol {
+	s.PreserveAspectRatio = nil
+
+	return s
+}
+
+// AddAttr adds a new attribute of a Symbol
+func (s Symbol) AddAttr(name, value string) Symbol {
+	s.lock.Lock()
+	s.Attrs = append(s.Attrs, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+	s.lock.Unlock()
+
+	return s
+}
+
+// RemoveAttr removes all attributes of a given name of a Symbol
+func (s Symbol) RemoveAttr(name string) Symbol {
+	s.lock.Lock()
+	var attrs []xml.Attr
+	for _, attr := range s.Attrs {
+		if attr.Name.Local != name {
+			attrs = append(attrs, attr)
+		}
+	}
+	s.Attrs = attrs
+	s.lock.Unlock()
+
+	return s
+}
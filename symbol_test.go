@@ -0,0 +1,47 @@
+package svg
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestSymbol_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       Symbol
+		want    string
+		wantErr bool
+	}{
+		{
+			"simple symbol",
+			NewSymbol("icon"),
+			`<symbol id="icon"></symbol>`,
+			false,
+		},
+		{
+			"symbol with viewBox and preserveAspectRatio",
+			NewSymbol("icon", C(5, 5, 5)).SetViewBox(VB(0, 0, 10, 10)).SetPreserveAspectRatio(PAR(AlignXMidYMid, Meet)),
+			`<symbol viewBox="0 0 10 10" preserveAspectRatio="xMidYMid meet" id="icon"><circle cx="5" cy="5" r="5"></circle></symbol>`,
+			false,
+		},
+		{
+			"symbol with attribute",
+			NewSymbol("icon").AddAttr("foo", "Foo").AddAttr("bar", "Bar").RemoveAttr("foo"),
+			`<symbol id="icon" bar="Bar"></symbol>`,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBytes, err := xml.Marshal(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("xml.Marshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := string(gotBytes)
+			if got != tt.want {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -12,10 +12,11 @@ type Text struct {
 	X          *Length     `xml:"x,attr,omitempty"`
 	Y          *Length     `xml:"y,attr,omitempty"`
 	TextAnchor *TextAnchor `xml:"text-anchor,attr,omitempty"`
-	Fill       *Color      `xml:"stroke,attr,omitempty"`
-	Attrs      []xml.Attr  `xml:",attr"`
-	Children   []interface{}
-	lock       *sync.Mutex
+	Transform  *Transform  `xml:"transform,attr,omitempty"`
+	Presentation
+	Attrs    []xml.Attr `xml:",attr"`
+	Children []interface{}
+	lock     *sync.Mutex
 }
 
 // T constructs new Text element (shortcut)
@@ -54,30 +55,30 @@ func NewText(x, y *Length, children ...interface{}) Text {
 	return t
 }
 
-// SetFill sets the fill color of a Text
-func (t Text) SetFill(fill Color) Text {
-	t.Fill = &fill
+// SetTextAnchor sets the text anchor of a Text
+func (t Text) SetTextAnchor(ta TextAnchor) Text {
+	t.TextAnchor = &ta
 
 	return t
 }
 
-// UnsetFill removes the previously set fill color of a Text
-func (t Text) UnsetFill() Text {
-	t.Fill = nil
+// UnsetFill removes the previously set text anchor of a Text
+func (t Text) UnsetTextAnchor() Text {
+	t.TextAnchor = nil
 
 	return t
 }
 
-// SetTextAnchor sets the text anchor of a Text
-func (t Text) SetTextAnchor(ta TextAnchor) Text {
-	t.TextAnchor = &ta
+// SetTransform sets the transform of a Text
+func (t Text) SetTransform(transform Transform) Text {
+	t.Transform = &transform
 
 	return t
 }
 
-// UnsetFill removes the previously set text anchor of a Text
-func (t Text) UnsetTextAnchor() Text {
-	t.TextAnchor = nil
+// UnsetTransform removes the previously set transform of a Text
+func (t Text) UnsetTransform() Text {
+	t.Transform = nil
 
 	return t
 }
@@ -105,3 +106,8 @@ func (t Text) RemoveAttr(name string) Text {
 
 	return t
 }
+
+// BBox returns the bounding box of the glyphs of a Text measured with fm, without its transform
+func (t Text) BBox(fm FontMetrics) BBox {
+	return NewGeometry(defaultGeometry.Lengths, fm).BBox(t)
+}
@@ -0,0 +1,177 @@
+package svg
+
+import (
+	"html"
+	"unicode/utf8"
+)
+
+// FontMetrics measures text, it is needed to lay out Text elements without a font engine
+type FontMetrics interface {
+	// Advance returns the width of s in user units
+	Advance(s string) float64
+	// Extent returns how far glyphs reach above (ascent) and below (descent) the baseline in user units
+	Extent() (ascent, descent float64)
+}
+
+// FixedFontMetrics approximates a font by giving every character the same width
+// CharWidth, Ascent and Descent are relative to FontSize, e.g. 0.6 is 60% of the font size.
+type FixedFontMetrics struct {
+	FontSize  float64
+	CharWidth float64
+	Ascent    float64
+	Descent   float64
+}
+
+// NewFixedFontMetrics constructs FixedFontMetrics with proportions close to common sans-serif fonts
+func NewFixedFontMetrics(fontSize float64) FixedFontMetrics {
+	return FixedFontMetrics{
+		FontSize:  fontSize,
+		CharWidth: 0.6,
+		Ascent:    0.8,
+		Descent:   0.2,
+	}
+}
+
+// Advance returns the width of s in user units
+func (fm FixedFontMetrics) Advance(s string) float64 {
+	return float64(utf8.RuneCountInString(s)) * fm.CharWidth * fm.FontSize
+}
+
+// Extent returns how far glyphs reach above and below the baseline in user units
+func (fm FixedFontMetrics) Extent() (float64, float64) {
+	return fm.Ascent * fm.FontSize, fm.Descent * fm.FontSize
+}
+
+// textRun is a piece of text placed on the baseline at X, Y
+// Anchor is the text-anchor of a text chunk consisting of the run alone, so renderers measuring text
+// themselves can align it, otherwise it is Start. X is already aligned with the FontMetrics either way.
+type textRun struct {
+	X      float64
+	Y      float64
+	Text   string
+	Width  float64
+	Anchor TextAnchor
+}
+
+// layoutText places the character data of a Text and its TSpan children
+// Each absolutely positioned TSpan starts a new text chunk, which is aligned as a whole by the
+// text-anchor of the Text.
+// See: https://www.w3.org/TR/SVG11/text.html#TextLayoutIntroduction
+func layoutText(t Text, lr LengthResolver, fm FontMetrics) []textRun {
+	tl := textLayouter{lr: lr, fm: fm, x: lr.resolve(t.X, AxisX), y: lr.resolve(t.Y, AxisY)}
+	if t.TextAnchor != nil {
+		tl.anchor = *t.TextAnchor
+	}
+
+	tl.addChildren(t.Children)
+	tl.alignChunk()
+
+	return tl.runs
+}
+
+type textLayouter struct {
+	lr     LengthResolver
+	fm     FontMetrics
+	anchor TextAnchor
+	x, y   float64
+	runs   []textRun
+	// chunk is the index of the first run of the current text chunk
+	chunk int
+}
+
+func (tl *textLayouter) addChildren(children []interface{}) {
+	for _, child := range children {
+		switch c := child.(type) {
+		case CharData:
+			tl.addText(string(c))
+		case string:
+			tl.addText(c)
+		case TSpan:
+			tl.addTSpan(c)
+		}
+	}
+}
+
+func (tl *textLayouter) addTSpan(ts TSpan) {
+	if ts.X != nil || ts.Y != nil {
+		tl.alignChunk()
+	}
+
+	if ts.X != nil {
+		tl.x = tl.lr.Resolve(*ts.X, AxisX)
+	}
+	if ts.Y != nil {
+		tl.y = tl.lr.Resolve(*ts.Y, AxisY)
+	}
+
+	tl.x += tl.lr.resolve(ts.DX, AxisX)
+	tl.y += tl.lr.resolve(ts.DY, AxisY)
+
+	tl.addText(html.UnescapeString(ts.Text))
+	tl.addChildren(ts.Children)
+}
+
+func (tl *textLayouter) addText(s string) {
+	if s == "" {
+		return
+	}
+
+	w := tl.fm.Advance(s)
+
+	tl.runs = append(tl.runs, textRun{X: tl.x, Y: tl.y, Text: s, Width: w})
+	tl.x += w
+}
+
+// alignChunk moves the runs of the current text chunk according to the text anchor
+func (tl *textLayouter) alignChunk() {
+	runs := tl.runs[tl.chunk:]
+	tl.chunk = len(tl.runs)
+
+	if len(runs) == 0 || tl.anchor == Start {
+		return
+	}
+
+	width := runs[len(runs)-1].X + runs[len(runs)-1].Width - runs[0].X
+
+	shift := -width
+	if tl.anchor == Middle {
+		shift /= 2
+	}
+
+	for i := range runs {
+		runs[i].X += shift
+	}
+
+	if len(runs) == 1 {
+		runs[0].Anchor = tl.anchor
+	}
+}
+
+// anchorX returns the x coordinate of the anchor point of a run, which is where its Anchor aligns it
+func (r textRun) anchorX() float64 {
+	return r.X + r.X - anchorStart(r.X, r.Width, r.Anchor)
+}
+
+// anchorStart returns where text of width w starts if anchor aligns it to x
+func anchorStart(x, w float64, anchor TextAnchor) float64 {
+	switch anchor {
+	case Middle:
+		return x - w/2
+	case End:
+		return x - w
+	}
+
+	return x
+}
+
+// textBBox returns the bounding box of laid out text, transformed by m
+func textBBox(runs []textRun, fm FontMetrics, m Matrix) BBox {
+	ascent, descent := fm.Extent()
+
+	b := EmptyBBox()
+	for _, r := range runs {
+		b = b.Union(BBox{MinX: r.X, MinY: r.Y - ascent, MaxX: r.X + r.Width, MaxY: r.Y + descent}.Transform(m))
+	}
+
+	return b
+}
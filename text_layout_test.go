@@ -0,0 +1,50 @@
+package svg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLayoutText(t *testing.T) {
+	fm := FixedFontMetrics{FontSize: 10, CharWidth: 0.5, Ascent: 0.8, Descent: 0.2}
+	lr := NewLengthResolver(100, 100)
+
+	tests := []struct {
+		name string
+		text Text
+		want []textRun
+	}{
+		{
+			"start",
+			T(10, 20, CharData("ab"), TS("cde").SDy(-2)),
+			[]textRun{{X: 10, Y: 20, Text: "ab", Width: 10}, {X: 20, Y: 18, Text: "cde", Width: 15}},
+		},
+		{
+			"middle",
+			T(10, 20, TS("abcd")).SetTextAnchor(Middle),
+			[]textRun{{X: 0, Y: 20, Text: "abcd", Width: 20, Anchor: Middle}},
+		},
+		{
+			"end with chunks",
+			T(50, 20, TS("ab"), TS("cd").SX(50).SY(40), TS("&amp;")).SetTextAnchor(End),
+			[]textRun{{X: 40, Y: 20, Text: "ab", Width: 10, Anchor: End}, {X: 35, Y: 40, Text: "cd", Width: 10}, {X: 45, Y: 40, Text: "&", Width: 5}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := layoutText(tt.text, lr, fm); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("layoutText() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestText_BBox(t *testing.T) {
+	fm := NewFixedFontMetrics(10)
+
+	got := T(10, 20, TS("abc")).SetTextAnchor(Middle).SetTransform(NewTransform().Scale(2, 2)).BBox(fm)
+	if want := BBoxOf(1, 12, 18, 10); !bboxAlmostEqual(got, want) {
+		t.Errorf("BBox() = %v, want %v", got, want)
+	}
+}
@@ -72,13 +72,14 @@ func TestT(t *testing.T) {
 
 func TestText_UnsetFill(t1 *testing.T) {
 	red, _ := ColorFromHexaString("#f00")
+	fill := ColorPaint(red)
 
 	type fields struct {
 		XMLName    xml.Name
 		X          *Length
 		Y          *Length
 		TextAnchor *TextAnchor
-		Fill       *Color
+		Fill       *Paint
 		Children   []interface{}
 	}
 	tests := []struct {
@@ -88,19 +89,19 @@ func TestText_UnsetFill(t1 *testing.T) {
 	}{
 		{
 			"default",
-			fields{Fill: &red},
+			fields{Fill: &fill},
 			Text{},
 		},
 	}
 	for _, tt := range tests {
 		t1.Run(tt.name, func(t1 *testing.T) {
 			t := Text{
-				XMLName:    tt.fields.XMLName,
-				X:          tt.fields.X,
-				Y:          tt.fields.Y,
-				TextAnchor: tt.fields.TextAnchor,
-				Fill:       tt.fields.Fill,
-				Children:   tt.fields.Children,
+				XMLName:      tt.fields.XMLName,
+				X:            tt.fields.X,
+				Y:            tt.fields.Y,
+				TextAnchor:   tt.fields.TextAnchor,
+				Presentation: Presentation{Fill: tt.fields.Fill},
+				Children:     tt.fields.Children,
 			}
 			if got := t.UnsetFill(); !reflect.DeepEqual(got, tt.want) {
 				t1.Errorf("UnsetFill() = %v, want %v", got, tt.want)
@@ -116,7 +117,7 @@ func TestText_UnsetTextAnchor(t1 *testing.T) {
 		X          *Length
 		Y          *Length
 		TextAnchor *TextAnchor
-		Fill       *Color
+		Fill       *Paint
 		Children   []interface{}
 	}
 	tests := []struct {
@@ -133,12 +134,12 @@ func TestText_UnsetTextAnchor(t1 *testing.T) {
 	for _, tt := range tests {
 		t1.Run(tt.name, func(t1 *testing.T) {
 			t := Text{
-				XMLName:    tt.fields.XMLName,
-				X:          tt.fields.X,
-				Y:          tt.fields.Y,
-				TextAnchor: tt.fields.TextAnchor,
-				Fill:       tt.fields.Fill,
-				Children:   tt.fields.Children,
+				XMLName:      tt.fields.XMLName,
+				X:            tt.fields.X,
+				Y:            tt.fields.Y,
+				TextAnchor:   tt.fields.TextAnchor,
+				Presentation: Presentation{Fill: tt.fields.Fill},
+				Children:     tt.fields.Children,
 			}
 			if got := t.UnsetTextAnchor(); !reflect.DeepEqual(got, tt.want) {
 				t1.Errorf("UnsetTextAnchor() = %v, want %v", got, tt.want)
@@ -160,6 +161,18 @@ func TestText_MarshalText(t *testing.T) {
 			[]string{`<text y="100"></text>`},
 			false,
 		},
+		{
+			"filled text",
+			T(0, 100).SetFill(Red.ToColor()),
+			[]string{`<text y="100" fill="#ff0000"></text>`},
+			false,
+		},
+		{
+			"text filled with a gradient",
+			T(0, 100).SetFillPaint(URLPaint("grad")),
+			[]string{`<text y="100" fill="url(#grad)"></text>`},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
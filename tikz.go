@@ -0,0 +1,297 @@
+package svg
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// WriteTikZ writes an SVG tag to w as a tikzpicture environment, e.g. to \input it into a LaTeX document
+// using the tikz package
+// Shapes become \fill and \draw commands, colors are defined with \definecolor and transforms become
+// scopes. Containers and shapes both filled and stroked with an opacity become transparency groups.
+// Text becomes nodes set in the font of the document, aligned by the text-anchor of the Text.
+// A user unit is a pixel, i.e. 0.75bp, and the y axis points downwards like in SVG. The bounding box of
+// the picture is the viewport of the SVG if it has a size. Paint servers, e.g. gradients, are replaced
+// by their fallback color if there is one.
+func WriteTikZ(w io.Writer, s SVG) error {
+	width, height := s.intrinsicSize()
+
+	tc := newTikZContent()
+	if width > 0 && height > 0 {
+		tc.line(`\useasboundingbox (0,0) rectangle %s;`, tikzPoint(width, height))
+	}
+
+	if err := Render(s, tc, NewLengthResolver(width, height)); err != nil {
+		return err
+	}
+
+	return tc.writeDocument(w)
+}
+
+// tikzContent is the Renderer collecting the commands of a tikzpicture together with the colors they use
+type tikzContent struct {
+	body bytes.Buffer
+	// colors holds the names of the colors used in the order of their first use
+	colors  []string
+	defined map[string]bool
+	// matrices holds the transformations of the scopes, the last one maps the current user space to pixels
+	matrices []Matrix
+	paint    PaintStyle
+}
+
+func newTikZContent() *tikzContent {
+	return &tikzContent{defined: map[string]bool{}, matrices: []Matrix{IdentityMatrix()}}
+}
+
+// line writes a command indented by the depth of the current scope
+func (tc *tikzContent) line(format string, args ...interface{}) {
+	tc.body.WriteString(strings.Repeat("  ", len(tc.matrices)-1))
+	fmt.Fprintf(&tc.body, format, args...)
+	tc.body.WriteByte('\n')
+}
+
+func (tc *tikzContent) FontMetrics() FontMetrics {
+	return NewHelveticaMetrics(DefaultFontSize)
+}
+
+// PushTransform starts a scope, its cm option transforms the canvas, which is flipped by the unit
+// vectors of the picture, so the matrix is flipped as well
+func (tc *tikzContent) PushTransform(m Matrix) {
+	if m == IdentityMatrix() {
+		tc.line(`\begin{scope}`)
+	} else {
+		tc.line(`\begin{scope}[cm={%s,%s,%s,%s,%s}]`, psNumber(m.A), psNumber(-m.B), psNumber(-m.C), psNumber(m.D), tikzPoint(m.E, m.F))
+	}
+
+	tc.matrices = append(tc.matrices, tc.matrix().Multiply(m))
+}
+
+func (tc *tikzContent) PopTransform() {
+	tc.matrices = tc.matrices[:len(tc.matrices)-1]
+	tc.line(`\end{scope}`)
+}
+
+func (tc *tikzContent) matrix() Matrix {
+	return tc.matrices[len(tc.matrices)-1]
+}
+
+// dimension returns a length of the current user space in big points, as TikZ does not transform line
+// widths, dashes and text, the scale of the scopes is applied
+func (tc *tikzContent) dimension(l float64) string {
+	return psNumber(l*math.Sqrt(math.Abs(tc.matrix().Determinant()))*pointsPerPixel) + "bp"
+}
+
+// color returns the name of c defining it on its first use, the alpha channel is left out
+func (tc *tikzContent) color(c Color) string {
+	name := "svg" + strings.TrimPrefix(c.Opaque().Format(ColorHex), "#")
+	if !tc.defined[name] {
+		tc.defined[name] = true
+		tc.colors = append(tc.colors, fmt.Sprintf(`\definecolor{%s}{RGB}{%d,%d,%d}`, name, c.R, c.G, c.B))
+	}
+
+	return name
+}
+
+func (tc *tikzContent) SetPaint(ps PaintStyle) {
+	tc.paint = ps
+}
+
+func (tc *tikzContent) FillPath(pd PathData) {
+	ps := tc.paint
+	if ps.Fill == nil {
+		return
+	}
+
+	path := tikzPath(pd)
+	if path == "" {
+		return
+	}
+
+	options := []string{"fill=" + tc.color(*ps.Fill)}
+	if ps.Fill.A < 255 {
+		options = append(options, "fill opacity="+psNumber(float64(ps.Fill.A)/255))
+	}
+	if ps.FillRule == FillRuleEvenOdd {
+		options = append(options, "even odd rule")
+	}
+
+	tc.line(`\fill[%s] %s;`, strings.Join(options, ", "), path)
+}
+
+func (tc *tikzContent) StrokePath(pd PathData) {
+	ps := tc.paint
+	if ps.Stroke == nil {
+		return
+	}
+
+	path := tikzPath(pd)
+	if path == "" {
+		return
+	}
+
+	options := []string{"draw=" + tc.color(*ps.Stroke)}
+	if ps.Stroke.A < 255 {
+		options = append(options, "draw opacity="+psNumber(float64(ps.Stroke.A)/255))
+	}
+
+	options = append(options,
+		"line width="+tc.dimension(ps.StrokeWidth),
+		"line cap="+tikzLinecap(ps.Linecap),
+		"line join="+tikzLinejoin(ps.Linejoin),
+		"miter limit="+psNumber(ps.Miterlimit),
+	)
+
+	if len(ps.Dashes) > 0 {
+		pattern := make([]string, 0, len(ps.Dashes))
+		for i, d := range ps.Dashes {
+			if i%2 == 0 {
+				pattern = append(pattern, "on "+tc.dimension(d))
+			} else {
+				pattern = append(pattern, "off "+tc.dimension(d))
+			}
+		}
+
+		options = append(options, "dash pattern="+strings.Join(pattern, " "), "dash phase="+tc.dimension(ps.DashOffset))
+	}
+
+	tc.line(`\draw[%s] %s;`, strings.Join(options, ", "), path)
+}
+
+// DrawText writes a node of the text, nodes are transformed along with the scopes they are in
+func (tc *tikzContent) DrawText(x, y float64, text string, anchor TextAnchor) {
+	if tc.paint.Fill == nil {
+		return
+	}
+
+	options := []string{"anchor=" + tikzAnchor(anchor), "inner sep=0pt", "text=" + tc.color(*tc.paint.Fill)}
+	if tc.paint.Fill.A < 255 {
+		options = append(options, "text opacity="+psNumber(float64(tc.paint.Fill.A)/255))
+	}
+	if tc.matrix() != IdentityMatrix() {
+		options = append(options, "transform shape")
+	}
+
+	tc.line(`\node[%s] at %s {%s};`, strings.Join(options, ", "), tikzPoint(x, y), texString(text))
+}
+
+func (tc *tikzContent) Clip(pd PathData, rule FillRule) {
+	path := tikzPath(pd)
+	if path == "" {
+		return
+	}
+
+	if rule == FillRuleEvenOdd {
+		tc.line(`\clip[even odd rule] %s;`, path)
+	} else {
+		tc.line(`\clip %s;`, path)
+	}
+}
+
+// PushLayer starts a scope which is a transparency group
+func (tc *tikzContent) PushLayer(opacity float64) {
+	tc.line(`\begin{scope}[transparency group, opacity=%s]`, psNumber(opacity))
+
+	tc.matrices = append(tc.matrices, tc.matrix())
+}
+
+func (tc *tikzContent) PopLayer() {
+	tc.PopTransform()
+}
+
+// writeDocument writes the complete tikzpicture environment
+func (tc *tikzContent) writeDocument(w io.Writer) error {
+	var buf bytes.Buffer
+
+	// the unit vectors make a user unit a pixel and flip the y axis, which points upwards in TikZ
+	fmt.Fprintf(&buf, "\\begin{tikzpicture}[x=%sbp, y=-%sbp]\n", psNumber(pointsPerPixel), psNumber(pointsPerPixel))
+	for _, c := range tc.colors {
+		buf.WriteString(c)
+		buf.WriteByte('\n')
+	}
+	buf.Write(tc.body.Bytes())
+	buf.WriteString("\\end{tikzpicture}\n")
+
+	_, err := w.Write(buf.Bytes())
+
+	return err
+}
+
+func tikzPoint(x, y float64) string {
+	return "(" + psNumber(x) + "," + psNumber(y) + ")"
+}
+
+// tikzPath returns the path operations constructing a normalized PathData
+func tikzPath(pd PathData) string {
+	var ops []string
+
+	forEachSegment(pd, pathSink{
+		moveTo: func(x, y float64) { ops = append(ops, tikzPoint(x, y)) },
+		lineTo: func(x, y float64) { ops = append(ops, "-- "+tikzPoint(x, y)) },
+		curveTo: func(x1, y1, x2, y2, x, y float64) {
+			ops = append(ops, ".. controls "+tikzPoint(x1, y1)+" and "+tikzPoint(x2, y2)+" .. "+tikzPoint(x, y))
+		},
+		close: func() { ops = append(ops, "-- cycle") },
+	})
+
+	return strings.Join(ops, " ")
+}
+
+// tikzAnchor returns the anchor of a node placing its baseline like text-anchor does
+func tikzAnchor(anchor TextAnchor) string {
+	switch anchor {
+	case Middle:
+		return "base"
+	case End:
+		return "base east"
+	}
+
+	return "base west"
+}
+
+func tikzLinecap(lc StrokeLinecap) string {
+	switch lc {
+	case LinecapRound:
+		return "round"
+	case LinecapSquare:
+		return "rect"
+	}
+
+	return "butt"
+}
+
+// tikzLinejoin returns the TikZ name of a line join style, unsupported ones are miter
+func tikzLinejoin(lj StrokeLinejoin) string {
+	switch lj {
+	case LinejoinRound:
+		return "round"
+	case LinejoinBevel:
+		return "bevel"
+	}
+
+	return "miter"
+}
+
+// texReplacer escapes the characters having a special meaning in TeX or missing from its default font encoding
+var texReplacer = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`$`, `\$`,
+	`&`, `\&`,
+	`#`, `\#`,
+	`%`, `\%`,
+	`_`, `\_`,
+	`^`, `\textasciicircum{}`,
+	`~`, `\textasciitilde{}`,
+	`<`, `\textless{}`,
+	`>`, `\textgreater{}`,
+	`|`, `\textbar{}`,
+)
+
+// texString returns s escaped for TeX, so it is typeset literally
+func texString(s string) string {
+	return texReplacer.Replace(s)
+}
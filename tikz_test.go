@@ -0,0 +1,119 @@
+package svg
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWriteTikZ(t *testing.T) {
+	red := Red.ToColor()
+
+	tests := []struct {
+		name string
+		s    SVG
+		want []string
+	}{
+		{
+			"picture",
+			NewSVG(100, 50),
+			[]string{"\\begin{tikzpicture}[x=0.75bp, y=-0.75bp]\n\\useasboundingbox (0,0) rectangle (100,50);\n\\end{tikzpicture}\n"},
+		},
+		{
+			"fill",
+			NewSVG(100, 50, R(1, 2, 3, 4).SetFill(red).SetFillOpacity(O(0.5))),
+			[]string{
+				"\\definecolor{svgff0000}{RGB}{255,0,0}\n",
+				"\\fill[fill=svgff0000, fill opacity=0.502] (1,2) -- (4,2) -- (4,6) -- (1,6) -- cycle;\n",
+			},
+		},
+		{
+			"even-odd",
+			NewSVG(100, 50, Pg(Points{{0, 0}, {1, 0}, {0, 1}}).SetFillRule(FillRuleEvenOdd)),
+			[]string{"\\fill[fill=svg000000, even odd rule] (0,0) -- (1,0) -- (0,1) -- cycle;\n"},
+		},
+		{
+			"stroke",
+			NewSVG(100, 50, NewPath(nil).SetD(NewPathData().MoveTo(0, 0).QuadTo(3, 3, 6, 0)).SetFillPaint(NonePaint()).SetStroke(red).
+				SetStrokeWidth(Lth(2)).SetStrokeLinecap(LinecapSquare).SetStrokeLinejoin(LinejoinRound).SetStrokeDashArray(Lth(4), Lth(2))),
+			[]string{"\\draw[draw=svgff0000, line width=1.5bp, line cap=rect, line join=round, miter limit=4, dash pattern=on 3bp off 1.5bp, dash phase=0bp] (0,0) .. controls (2,2) and (4,2) .. (6,0);\n"},
+		},
+		{
+			"opacity of fill and stroke",
+			NewSVG(100, 50, R(1, 2, 3, 4).SetFill(red).SetStroke(Blue.ToColor()).SetOpacity(0.5)),
+			[]string{
+				"\\begin{scope}[transparency group, opacity=0.5]\n  \\fill[fill=svgff0000] (1,2)",
+				"  \\draw[draw=svg0000ff, line width=0.75bp,",
+				"\\end{scope}\n\\end{tikzpicture}\n",
+			},
+		},
+		{
+			"group transform",
+			NewSVG(100, 50, NewGroup(L(0, 0, 1, 0).SetStroke(red)).SetTransform(NewTransform().Translate(10, 20).Rotate(90).Scale(2, 2))),
+			[]string{
+				"\\begin{scope}[cm={0,-2,2,0,(10,20)}]\n",
+				"  \\draw[draw=svgff0000, line width=1.5bp,",
+				"\\end{scope}\n\\end{tikzpicture}\n",
+			},
+		},
+		{
+			"symbol viewport clip",
+			NewSVG(100, 50, NewDefs(NewSymbol("icon", C(5, 5, 10)).SetViewBox(VB(0, 0, 10, 10))), U("#icon", 0, 0).SetWidth(Lth(20)).SetHeight(Lth(20))),
+			[]string{"\\begin{scope}\n  \\clip (0,0) -- (20,0) -- (20,20) -- (0,20) -- cycle;\n  \\begin{scope}[cm={2,0,0,2,(0,0)}]\n"},
+		},
+		{
+			"text",
+			NewSVG(100, 50,
+				T(10, 20, CharData("50% of {x_1}")),
+				T(10, 40, CharData("b")).SetTextAnchor(Middle),
+				NewGroup(T(0, 0, CharData("c")).SetTextAnchor(End).SetFill(Red.ToColor())).SetTransform(NewTransform().Rotate(45)),
+			),
+			[]string{
+				"\\node[anchor=base west, inner sep=0pt, text=svg000000] at (10,20) {50\\% of \\{x\\_1\\}};\n",
+				"\\node[anchor=base, inner sep=0pt, text=svg000000] at (10,40) {b};\n",
+				"\\node[anchor=base east, inner sep=0pt, text=svgff0000, transform shape] at (0,0) {c};\n",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			if err := WriteTikZ(&buf, tt.s); err != nil {
+				t.Fatalf("WriteTikZ() error = %v", err)
+			}
+
+			for _, want := range tt.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("WriteTikZ() = %s, want to contain %s", buf.String(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteTikZ_invalidViewBox(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := WriteTikZ(&buf, NewSVG(10, 10).SetViewBox(VB(0, 0, 0, 10))); err == nil {
+		t.Errorf("WriteTikZ() error = %v, wantErr %v", err, true)
+	}
+}
+
+func TestTexString(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"plain é", "plain é"},
+		{`\{}$&#%_`, `\textbackslash{}\{\}\$\&\#\%\_`},
+		{"^~<>|", `\textasciicircum{}\textasciitilde{}\textless{}\textgreater{}\textbar{}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := texString(tt.s); got != tt.want {
+				t.Errorf("texString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
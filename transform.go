@@ -0,0 +1,342 @@
+package svg
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+type TransformType string
+
+const (
+	TMatrix    TransformType = "matrix"
+	TTranslate TransformType = "translate"
+	TScale     TransformType = "scale"
+	TRotate    TransformType = "rotate"
+	TSkewX     TransformType = "skewX"
+	TSkewY     TransformType = "skewY"
+)
+
+// argCounts returns the allowed number of arguments of a TransformType
+func (tt TransformType) argCounts() []int {
+	switch tt {
+	case TMatrix:
+		return []int{6}
+	case TTranslate, TScale:
+		return []int{1, 2}
+	case TRotate:
+		return []int{1, 3}
+	case TSkewX, TSkewY:
+		return []int{1}
+	}
+
+	return nil
+}
+
+// TransformOp represents a single transform function, e.g. rotate(45 10 10)
+type TransformOp struct {
+	Type TransformType
+	Args []float64
+}
+
+func (op TransformOp) String() string {
+	args := make([]string, 0, len(op.Args))
+	for _, a := range op.Args {
+		args = append(args, fmt.Sprintf("%v", a))
+	}
+
+	return fmt.Sprintf("%s(%s)", op.Type, strings.Join(args, " "))
+}
+
+// Matrix returns the affine matrix of a TransformOp
+func (op TransformOp) Matrix() (Matrix, error) {
+	if !containsInt(op.Type.argCounts(), len(op.Args)) {
+		return Matrix{}, fmt.Errorf("invalid number of arguments for %s: %d", op.Type, len(op.Args))
+	}
+
+	a := op.Args
+
+	switch op.Type {
+	case TMatrix:
+		return Matrix{a[0], a[1], a[2], a[3], a[4], a[5]}, nil
+	case TTranslate:
+		if len(a) == 1 {
+			return TranslateMatrix(a[0], 0), nil
+		}
+		return TranslateMatrix(a[0], a[1]), nil
+	case TScale:
+		if len(a) == 1 {
+			return ScaleMatrix(a[0], a[0]), nil
+		}
+		return ScaleMatrix(a[0], a[1]), nil
+	case TRotate:
+		if len(a) == 1 {
+			return RotateMatrix(a[0]), nil
+		}
+		return TranslateMatrix(a[1], a[2]).Multiply(RotateMatrix(a[0])).Multiply(TranslateMatrix(-a[1], -a[2])), nil
+	case TSkewX:
+		return Matrix{1, 0, math.Tan(a[0] * math.Pi / 180), 1, 0, 0}, nil
+	case TSkewY:
+		return Matrix{1, math.Tan(a[0] * math.Pi / 180), 0, 1, 0, 0}, nil
+	}
+
+	return Matrix{}, fmt.Errorf("invalid transform type: %s", op.Type)
+}
+
+// Transform represents the value of a transform attribute
+// See: https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/transform
+type Transform []TransformOp
+
+// NewTransform constructs new Transform
+func NewTransform(ops ...TransformOp) Transform {
+	t := Transform{}
+
+	return append(t, ops...)
+}
+
+func (t Transform) add(tt TransformType, args ...float64) Transform {
+	return append(t[:len(t):len(t)], TransformOp{Type: tt, Args: args})
+}
+
+// Matrix adds a matrix(a b c d e f) operation to a Transform
+func (t Transform) Matrix(a, b, c, d, e, f float64) Transform {
+	return t.add(TMatrix, a, b, c, d, e, f)
+}
+
+// Translate adds a translate(tx ty) operation to a Transform
+func (t Transform) Translate(tx, ty float64) Transform {
+	return t.add(TTranslate, tx, ty)
+}
+
+// Scale adds a scale(sx sy) operation to a Transform
+func (t Transform) Scale(sx, sy float64) Transform {
+	return t.add(TScale, sx, sy)
+}
+
+// Rotate adds a rotate(angle) operation to a Transform, the angle is in degrees
+func (t Transform) Rotate(angle float64) Transform {
+	return t.add(TRotate, angle)
+}
+
+// RotateAround adds a rotate(angle cx cy) operation to a Transform, the angle is in degrees
+func (t Transform) RotateAround(angle, cx, cy float64) Transform {
+	return t.add(TRotate, angle, cx, cy)
+}
+
+// SkewX adds a skewX(angle) operation to a Transform, the angle is in degrees
+func (t Transform) SkewX(angle float64) Transform {
+	return t.add(TSkewX, angle)
+}
+
+// SkewY adds a skewY(angle) operation to a Transform, the angle is in degrees
+func (t Transform) SkewY(angle float64) Transform {
+	return t.add(TSkewY, angle)
+}
+
+// ToMatrix collapses all operations of a Transform into a single affine matrix
+func (t Transform) ToMatrix() (Matrix, error) {
+	m := IdentityMatrix()
+
+	for _, op := range t {
+		om, err := op.Matrix()
+		if err != nil {
+			return Matrix{}, err
+		}
+
+		m = m.Multiply(om)
+	}
+
+	return m, nil
+}
+
+func (t Transform) String() string {
+	s := make([]string, 0, len(t))
+
+	for _, op := range t {
+		s = append(s, op.String())
+	}
+
+	return strings.Join(s, " ")
+}
+
+func (t Transform) MarshalText() ([]byte, error) {
+	for _, op := range t {
+		if !containsInt(op.Type.argCounts(), len(op.Args)) {
+			return nil, fmt.Errorf("invalid number of arguments for %s: %d", op.Type, len(op.Args))
+		}
+	}
+
+	s := t.String()
+
+	return []byte(s), nil
+}
+
+// MarshalXMLAttr marshals a Transform as an attribute, which is left out if it has no operations
+func (t Transform) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if len(t) == 0 {
+		return xml.Attr{}, nil
+	}
+
+	text, err := t.MarshalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+func (t *Transform) UnmarshalText(text []byte) error {
+	res, err := parseTransform(string(text))
+	if err != nil {
+		return renameParseError("transform", err)
+	}
+
+	*t = res
+
+	return nil
+}
+
+func parseTransform(s string) (Transform, error) {
+	p := pathDataParser{s: s}
+
+	res := Transform{}
+
+	p.skipWsp()
+	for !p.eof() {
+		start := p.pos
+		for !p.eof() && p.s[p.pos] != '(' && !isPathWsp(p.s[p.pos]) {
+			p.pos++
+		}
+
+		tt := TransformType(p.s[start:p.pos])
+		if tt.argCounts() == nil {
+			p.pos = start
+			return nil, p.errorf("invalid transform type %q", string(tt))
+		}
+
+		p.skipWsp()
+		if p.eof() || p.s[p.pos] != '(' {
+			return nil, p.errorf("expected '(' after %s", tt)
+		}
+		p.pos++
+
+		var args []float64
+		p.skipWsp()
+		for !p.eof() && p.s[p.pos] != ')' {
+			n, err := p.number()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, n)
+			p.skipCommaWsp()
+		}
+
+		if p.eof() {
+			return nil, p.errorf("expected ')' after %s arguments", tt)
+		}
+		p.pos++
+
+		if !containsInt(tt.argCounts(), len(args)) {
+			return nil, p.errorf("invalid number of arguments for %s: %d", tt, len(args))
+		}
+
+		res = append(res, TransformOp{Type: tt, Args: args})
+
+		p.skipCommaWsp()
+	}
+
+	return res, nil
+}
+
+// Matrix represents a 2x3 affine transformation matrix
+// The fields follow the order of the SVG matrix(a b c d e f) transform function
+//
+//	| A C E |
+//	| B D F |
+//	| 0 0 1 |
+type Matrix struct {
+	A, B, C, D, E, F float64
+}
+
+// IdentityMatrix returns a Matrix which does not transform at all
+func IdentityMatrix() Matrix {
+	return Matrix{A: 1, D: 1}
+}
+
+// TranslateMatrix returns a Matrix which moves points by tx and ty
+func TranslateMatrix(tx, ty float64) Matrix {
+	return Matrix{A: 1, D: 1, E: tx, F: ty}
+}
+
+// ScaleMatrix returns a Matrix which scales points by sx and sy
+func ScaleMatrix(sx, sy float64) Matrix {
+	return Matrix{A: sx, D: sy}
+}
+
+// RotateMatrix returns a Matrix which rotates points around the origin, the angle is in degrees
+func RotateMatrix(angle float64) Matrix {
+	sin, cos := math.Sincos(angle * math.Pi / 180)
+
+	return Matrix{A: cos, B: sin, C: -sin, D: cos}
+}
+
+// Multiply returns the product of m and n, the resulting Matrix applies n first, then m
+func (m Matrix) Multiply(n Matrix) Matrix {
+	return Matrix{
+		A: m.A*n.A + m.C*n.B,
+		B: m.B*n.A + m.D*n.B,
+		C: m.A*n.C + m.C*n.D,
+		D: m.B*n.C + m.D*n.D,
+		E: m.A*n.E + m.C*n.F + m.E,
+		F: m.B*n.E + m.D*n.F + m.F,
+	}
+}
+
+// Compose returns a Matrix which applies m first, then n
+func (m Matrix) Compose(n Matrix) Matrix {
+	return n.Multiply(m)
+}
+
+// Determinant returns the determinant of the linear part of a Matrix
+func (m Matrix) Determinant() float64 {
+	return m.A*m.D - m.B*m.C
+}
+
+// Invert returns the inverse of a Matrix
+func (m Matrix) Invert() (Matrix, error) {
+	det := m.Determinant()
+	if det == 0 {
+		return Matrix{}, errors.New("matrix is not invertible")
+	}
+
+	return Matrix{
+		A: m.D / det,
+		B: -m.B / det,
+		C: -m.C / det,
+		D: m.A / det,
+		E: (m.C*m.F - m.D*m.E) / det,
+		F: (m.B*m.E - m.A*m.F) / det,
+	}, nil
+}
+
+// Apply transforms the point (x, y)
+func (m Matrix) Apply(x, y float64) (float64, float64) {
+	return m.A*x + m.C*y + m.E, m.B*x + m.D*y + m.F
+}
+
+// ToTransform returns a Transform consisting of a single matrix operation
+func (m Matrix) ToTransform() Transform {
+	return NewTransform().Matrix(m.A, m.B, m.C, m.D, m.E, m.F)
+}
+
+func containsInt(list []int, n int) bool {
+	for _, i := range list {
+		if i == n {
+			return true
+		}
+	}
+
+	return false
+}
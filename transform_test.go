@@ -0,0 +1,244 @@
+package svg
+
+import (
+	"encoding/xml"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func matrixAlmostEqual(a, b Matrix) bool {
+	const eps = 1e-9
+
+	return math.Abs(a.A-b.A) < eps && math.Abs(a.B-b.B) < eps && math.Abs(a.C-b.C) < eps &&
+		math.Abs(a.D-b.D) < eps && math.Abs(a.E-b.E) < eps && math.Abs(a.F-b.F) < eps
+}
+
+func TestTransform_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		t       Transform
+		want    []byte
+		wantErr bool
+	}{
+		{
+			"empty",
+			NewTransform(),
+			[]byte(""),
+			false,
+		},
+		{
+			"all operations",
+			NewTransform().Translate(10, 20).Scale(2, 2).Rotate(45).RotateAround(30, 5, 5).SkewX(10).SkewY(-10).Matrix(1, 0, 0, 1, 0.5, 0),
+			[]byte("translate(10 20) scale(2 2) rotate(45) rotate(30 5 5) skewX(10) skewY(-10) matrix(1 0 0 1 0.5 0)"),
+			false,
+		},
+		{
+			"invalid number of arguments",
+			NewTransform(TransformOp{Type: TRotate, Args: []float64{1, 2}}),
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.t.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %v, want %v", string(got), string(tt.want))
+			}
+		})
+	}
+}
+
+func TestTransform_MarshalXMLAttr(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Transform
+		want xml.Attr
+	}{
+		{"empty is left out", NewTransform(), xml.Attr{}},
+		{"operations", NewTransform().Translate(1, 2), xml.Attr{Name: xml.Name{Local: "transform"}, Value: "translate(1 2)"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.t.MarshalXMLAttr(xml.Name{Local: "transform"})
+			if err != nil {
+				t.Fatalf("MarshalXMLAttr() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("MarshalXMLAttr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransform_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    []byte
+		want    Transform
+		wantErr bool
+	}{
+		{
+			"single operation",
+			[]byte("translate(10)"),
+			Transform{{TTranslate, []float64{10}}},
+			false,
+		},
+		{
+			"list with commas and whitespace",
+			[]byte(" translate(10,20), rotate( 45 1 2 )scale(.5-1) "),
+			NewTransform().Translate(10, 20).RotateAround(45, 1, 2).Scale(0.5, -1),
+			false,
+		},
+		{
+			"matrix",
+			[]byte("matrix(1 2 3 4 5 6)"),
+			NewTransform().Matrix(1, 2, 3, 4, 5, 6),
+			false,
+		},
+		{
+			"unknown operation",
+			[]byte("spin(10)"),
+			nil,
+			true,
+		},
+		{
+			"invalid number of arguments",
+			[]byte("rotate(1 2)"),
+			nil,
+			true,
+		},
+		{
+			"missing parenthesis",
+			[]byte("rotate(1"),
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Transform
+			if err := got.UnmarshalText(tt.text); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnmarshalText() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransform_ToMatrix(t *testing.T) {
+	tests := []struct {
+		name    string
+		t       Transform
+		want    Matrix
+		wantErr bool
+	}{
+		{
+			"empty",
+			NewTransform(),
+			IdentityMatrix(),
+			false,
+		},
+		{
+			"translate then scale",
+			NewTransform().Translate(10, 20).Scale(2, 3),
+			Matrix{2, 0, 0, 3, 10, 20},
+			false,
+		},
+		{
+			"uniform scale",
+			NewTransform(TransformOp{TScale, []float64{2}}),
+			Matrix{2, 0, 0, 2, 0, 0},
+			false,
+		},
+		{
+			"rotate",
+			NewTransform().Rotate(90),
+			Matrix{0, 1, -1, 0, 0, 0},
+			false,
+		},
+		{
+			"rotate around a centre",
+			NewTransform().RotateAround(90, 10, 10),
+			Matrix{0, 1, -1, 0, 20, 0},
+			false,
+		},
+		{
+			"skew",
+			NewTransform().SkewX(45).SkewY(45),
+			Matrix{2, 1, 1, 1, 0, 0},
+			false,
+		},
+		{
+			"invalid",
+			NewTransform(TransformOp{TSkewX, nil}),
+			Matrix{},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.t.ToMatrix()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ToMatrix() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !matrixAlmostEqual(got, tt.want) {
+				t.Errorf("ToMatrix() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatrix_Apply(t *testing.T) {
+	m, _ := NewTransform().RotateAround(90, 10, 10).ToMatrix()
+
+	x, y := m.Apply(20, 10)
+	if math.Abs(x-10) > 1e-9 || math.Abs(y-20) > 1e-9 {
+		t.Errorf("Apply() = %v,%v, want 10,20", x, y)
+	}
+}
+
+func TestMatrix_Compose(t *testing.T) {
+	translate := TranslateMatrix(10, 0)
+	scale := ScaleMatrix(2, 2)
+
+	x, y := translate.Compose(scale).Apply(1, 1)
+	if x != 22 || y != 2 {
+		t.Errorf("Compose() Apply() = %v,%v, want 22,2", x, y)
+	}
+}
+
+func TestMatrix_Invert(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       Matrix
+		wantErr bool
+	}{
+		{"identity", IdentityMatrix(), false},
+		{"complex", Matrix{2, 1, -1, 3, 5, -7}, false},
+		{"singular", Matrix{1, 2, 2, 4, 0, 0}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.Invert()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Invert() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if id := tt.m.Multiply(got); !matrixAlmostEqual(id, IdentityMatrix()) {
+				t.Errorf("Invert() m * inverse = %v, want identity", id)
+			}
+		})
+	}
+}
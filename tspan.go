@@ -8,11 +8,12 @@ import (
 // TSpan represents a TSpan SVG element
 // See: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/tspan
 type TSpan struct {
-	XMLName  xml.Name
-	X        *Length    `xml:"x,attr,omitempty"`
-	Y        *Length    `xml:"y,attr,omitempty"`
-	DX       *Length    `xml:"dx,attr,omitempty"`
-	DY       *Length    `xml:"dy,attr,omitempty"`
+	XMLName xml.Name
+	X       *Length `xml:"x,attr,omitempty"`
+	Y       *Length `xml:"y,attr,omitempty"`
+	DX      *Length `xml:"dx,attr,omitempty"`
+	DY      *Length `xml:"dy,attr,omitempty"`
+	Presentation
 	Attrs    []xml.Attr `xml:",attr"`
 	Text     string     `xml:",innerxml"`
 	Children []interface{}
@@ -0,0 +1,150 @@
+package svg
+
+import (
+	"encoding/xml"
+	"sync"
+)
+
+// Use represents a Use SVG element
+// See: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/use
+type Use struct {
+	XMLName   xml.Name
+	Href      string      `xml:"href,attr,omitempty"`
+	XLinkHref string      `xml:"http://www.w3.org/1999/xlink href,attr,omitempty"`
+	X         *LengthExpr `xml:"x,attr,omitempty"`
+	Y         *LengthExpr `xml:"y,attr,omitempty"`
+	Width     *LengthExpr `xml:"width,attr,omitempty"`
+	Height    *LengthExpr `xml:"height,attr,omitempty"`
+	Transform *Transform  `xml:"transform,attr,omitempty"`
+	Presentation
+	Attrs    []xml.Attr `xml:",attr"`
+	Children []interface{}
+	lock     *sync.Mutex
+}
+
+// U constructs new Use element (shortcut)
+func U(href string, x, y float64, children ...interface{}) Use {
+	var pX, pY *Length
+
+	if x != 0.0 {
+		pX = &Length{Number: x}
+	}
+
+	if y != 0.0 {
+		pY = &Length{Number: y}
+	}
+
+	return NewUse(href, pX, pY, children...)
+}
+
+// NewUse constructs new Use element
+func NewUse(href string, x, y *Length, children ...interface{}) Use {
+	u := Use{
+		XMLName: xml.Name{Local: "use"},
+		Href:    href,
+		X:       exprOf(x),
+		Y:       exprOf(y),
+		lock:    &sync.Mutex{},
+	}
+
+	u.Children = append(u.Children, children...)
+
+	return u
+}
+
+// SetHref sets the href attribute of a Use
+func (u Use) SetHref(href string) Use {
+	u.Href = href
+
+	return u
+}
+
+// SetXLinkHref sets the deprecated xlink:href attribute of a Use, for SVG 1.1 user agents
+func (u Use) SetXLinkHref(href string) Use {
+	u.XLinkHref = href
+
+	return u
+}
+
+// SetWidth sets the width of a Use
+func (u Use) SetWidth(width Length) Use {
+	u.Width = exprOf(&width)
+
+	return u
+}
+
+// UnsetWidth removes the previously set width of a Use
+func (u Use) UnsetWidth() Use {
+	u.Width = nil
+
+	return u
+}
+
+// SetHeight sets the height of a Use
+func (u Use) SetHeight(height Length) Use {
+	u.Height = exprOf(&height)
+
+	return u
+}
+
+// UnsetHeight removes the previously set height of a Use
+func (u Use) UnsetHeight() Use {
+	u.Height = nil
+
+	return u
+}
+
+// SetTransform sets the transform of a Use
+func (u Use) SetTransform(transform Transform) Use {
+	u.Transform = &transform
+
+	return u
+}
+
+// UnsetTransform removes the previously set transform of a Use
+func (u Use) UnsetTransform() Use {
+	u.Transform = nil
+
+	return u
+}
+
+// AddAttr adds a new attribute of a Use
+func (u Use) AddAttr(name, value string) Use {
+	u.lock.Lock()
+	u.Attrs = append(u.Attrs, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+	u.lock.Unlock()
+
+	return u
+}
+
+// RemoveAttr removes all attributes of a given name of a Use
+func (u Use) RemoveAttr(name string) Use {
+	u.lock.Lock()
+	var attrs []xml.Attr
+	for _, attr := range u.Attrs {
+		if attr.Name.Local != name {
+			attrs = append(attrs, attr)
+		}
+	}
+	u.Attrs = attrs
+	u.lock.Unlock()
+
+	return u
+}
+
+// UseFactory creates Use elements referencing a definition registered by SVG.Define
+type UseFactory struct {
+	ID string
+	// XLink makes the created Use elements carry an xlink:href fallback as well
+	XLink bool
+}
+
+// At creates a Use element which places the definition at x, y
+func (uf UseFactory) At(x, y float64) Use {
+	u := U("#"+uf.ID, x, y)
+	if uf.XLink {
+		u.XLinkHref = u.Href
+	}
+
+	return u
+}
@@ -0,0 +1,123 @@
+package svg
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestUse_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		u       Use
+		want    string
+		wantErr bool
+	}{
+		{
+			"simple use",
+			U("#icon", 0, 0),
+			`<use href="#icon"></use>`,
+			false,
+		},
+		{
+			"use with position and size",
+			U("#icon", 10, 20).SetWidth(Length{Number: 5}).SetHeight(Length{Number: 50, Type: Percent}),
+			`<use href="#icon" x="10" y="20" width="5" height="50%"></use>`,
+			false,
+		},
+		{
+			"use with size removed",
+			U("#icon", 10, 20).SetWidth(Length{Number: 5}).SetHeight(Length{Number: 5}).UnsetWidth().UnsetHeight(),
+			`<use href="#icon" x="10" y="20"></use>`,
+			false,
+		},
+		{
+			"use with xlink fallback",
+			U("#icon", 0, 0).SetXLinkHref("#icon"),
+			`<use href="#icon" xmlns:xlink="http://www.w3.org/1999/xlink" xlink:href="#icon"></use>`,
+			false,
+		},
+		{
+			"use with transform",
+			U("#icon", 0, 0).SetTransform(NewTransform().Scale(2, 2)),
+			`<use href="#icon" transform="scale(2 2)"></use>`,
+			false,
+		},
+		{
+			"use with attribute",
+			U("#icon", 0, 0).SetID("first").AddAttr("foo", "Foo").AddAttr("bar", "Bar").RemoveAttr("foo"),
+			`<use href="#icon" id="first" bar="Bar"></use>`,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBytes, err := xml.Marshal(tt.u)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("xml.Marshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := string(gotBytes)
+			if got != tt.want {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUse_UnmarshalXML(t *testing.T) {
+	input := `<use xmlns:xlink="http://www.w3.org/1999/xlink" xlink:href="#icon" x="1" y="2"></use>`
+
+	var u Use
+	if err := xml.NewDecoder(strings.NewReader(input)).Decode(&u); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	if u.XLinkHref != "#icon" {
+		t.Errorf("XLinkHref = %q, want %q", u.XLinkHref, "#icon")
+	}
+	if u.Href != "" {
+		t.Errorf("Href = %q, want empty", u.Href)
+	}
+	if u.X == nil || u.X.Length.Number != 1 || u.Y == nil || u.Y.Length.Number != 2 {
+		t.Errorf("X, Y = %v, %v, want 1, 2", u.X, u.Y)
+	}
+	if len(u.Attrs) != 0 {
+		t.Errorf("Attrs = %v, want none", u.Attrs)
+	}
+}
+
+func TestUseFactory_At(t *testing.T) {
+	tests := []struct {
+		name string
+		uf   UseFactory
+		x, y float64
+		want string
+	}{
+		{
+			"href only",
+			UseFactory{ID: "icon"},
+			3, 4,
+			`<use href="#icon" x="3" y="4"></use>`,
+		},
+		{
+			"with xlink fallback",
+			UseFactory{ID: "icon", XLink: true},
+			0, 0,
+			`<use href="#icon" xmlns:xlink="http://www.w3.org/1999/xlink" xlink:href="#icon"></use>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBytes, err := xml.Marshal(tt.uf.At(tt.x, tt.y))
+			if err != nil {
+				t.Errorf("xml.Marshal() error = %v", err)
+				return
+			}
+			got := string(gotBytes)
+			if got != tt.want {
+				t.Errorf("xml.Marshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package svg
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ViewBox represents the value of a viewBox attribute, the rectangle of user space mapped to the viewport
+// See: https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/viewBox
+type ViewBox struct {
+	MinX   float64
+	MinY   float64
+	Width  float64
+	Height float64
+}
+
+// VB constructs new ViewBox
+func VB(minX, minY, width, height float64) ViewBox {
+	return ViewBox{MinX: minX, MinY: minY, Width: width, Height: height}
+}
+
+// IsEmpty reports whether a ViewBox has no area, which disables rendering of the element
+func (vb ViewBox) IsEmpty() bool {
+	return vb.Width <= 0 || vb.Height <= 0
+}
+
+func (vb ViewBox) String() string {
+	return fmt.Sprintf("%v %v %v %v", vb.MinX, vb.MinY, vb.Width, vb.Height)
+}
+
+func (vb ViewBox) MarshalText() ([]byte, error) {
+	if vb.Width < 0 || vb.Height < 0 {
+		return nil, fmt.Errorf("invalid viewBox, negative size: %s", vb)
+	}
+
+	s := vb.String()
+
+	return []byte(s), nil
+}
+
+func (vb *ViewBox) UnmarshalText(text []byte) error {
+	p := pathDataParser{s: string(text)}
+
+	var n [4]float64
+
+	p.skipWsp()
+	for i := range n {
+		if i > 0 {
+			p.skipCommaWsp()
+		}
+
+		v, err := p.number()
+		if err != nil {
+			return renameParseError("viewBox", err)
+		}
+
+		n[i] = v
+	}
+
+	p.skipWsp()
+	if !p.eof() {
+		return renameParseError("viewBox", p.errorf("unexpected %q", p.s[p.pos]))
+	}
+
+	if n[2] < 0 || n[3] < 0 {
+		return fmt.Errorf("invalid viewBox, negative size: %s", string(text))
+	}
+
+	*vb = VB(n[0], n[1], n[2], n[3])
+
+	return nil
+}
+
+// AspectAlign defines how a ViewBox is aligned inside of a viewport of a different aspect ratio
+type AspectAlign string
+
+const (
+	AlignNone     AspectAlign = "none"
+	AlignXMinYMin AspectAlign = "xMinYMin"
+	AlignXMidYMin AspectAlign = "xMidYMin"
+	AlignXMaxYMin AspectAlign = "xMaxYMin"
+	AlignXMinYMid AspectAlign = "xMinYMid"
+	AlignXMidYMid AspectAlign = "xMidYMid"
+	AlignXMaxYMid AspectAlign = "xMaxYMid"
+	AlignXMinYMax AspectAlign = "xMinYMax"
+	AlignXMidYMax AspectAlign = "xMidYMax"
+	AlignXMaxYMax AspectAlign = "xMaxYMax"
+)
+
+// IsValid reports whether an AspectAlign is one of the known values
+func (a AspectAlign) IsValid() bool {
+	switch a {
+	case AlignNone,
+		AlignXMinYMin, AlignXMidYMin, AlignXMaxYMin,
+		AlignXMinYMid, AlignXMidYMid, AlignXMaxYMid,
+		AlignXMinYMax, AlignXMidYMax, AlignXMaxYMax:
+		return true
+	}
+
+	return false
+}
+
+// offsets returns how much of the free space is placed before the ViewBox horizontally and vertically
+func (a AspectAlign) offsets() (float64, float64) {
+	if a == AlignNone || !a.IsValid() {
+		return 0, 0
+	}
+
+	f := func(s string) float64 {
+		switch s {
+		case "Mid":
+			return 0.5
+		case "Max":
+			return 1
+		}
+
+		return 0
+	}
+
+	return f(string(a[1:4])), f(string(a[5:8]))
+}
+
+// MeetOrSlice defines whether a ViewBox is scaled to be fully visible or to cover the whole viewport
+type MeetOrSlice string
+
+const (
+	Meet  MeetOrSlice = "meet"
+	Slice MeetOrSlice = "slice"
+)
+
+// PreserveAspectRatio represents the value of a preserveAspectRatio attribute
+// The zero value is the default of SVG: xMidYMid meet
+// See: https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/preserveAspectRatio
+type PreserveAspectRatio struct {
+	Defer       bool
+	Align       AspectAlign
+	MeetOrSlice MeetOrSlice
+}
+
+// PAR constructs new PreserveAspectRatio
+func PAR(align AspectAlign, meetOrSlice ...MeetOrSlice) PreserveAspectRatio {
+	par := PreserveAspectRatio{Align: align}
+	if len(meetOrSlice) > 0 {
+		par.MeetOrSlice = meetOrSlice[0]
+	}
+
+	return par
+}
+
+func (par PreserveAspectRatio) String() string {
+	s := make([]string, 0, 3)
+
+	if par.Defer {
+		s = append(s, "defer")
+	}
+
+	if par.Align == "" {
+		s = append(s, string(AlignXMidYMid))
+	} else {
+		s = append(s, string(par.Align))
+	}
+
+	if par.MeetOrSlice != "" {
+		s = append(s, string(par.MeetOrSlice))
+	}
+
+	return strings.Join(s, " ")
+}
+
+func (par PreserveAspectRatio) MarshalText() ([]byte, error) {
+	if par.Align != "" && !par.Align.IsValid() {
+		return nil, fmt.Errorf("invalid preserveAspectRatio align: %s", par.Align)
+	}
+
+	if par.MeetOrSlice != "" && par.MeetOrSlice != Meet && par.MeetOrSlice != Slice {
+		return nil, fmt.Errorf("invalid preserveAspectRatio meetOrSlice: %s", par.MeetOrSlice)
+	}
+
+	s := par.String()
+
+	return []byte(s), nil
+}
+
+func (par *PreserveAspectRatio) UnmarshalText(text []byte) error {
+	fields := strings.Fields(string(text))
+
+	res := PreserveAspectRatio{}
+
+	if len(fields) > 0 && fields[0] == "defer" {
+		res.Defer = true
+		fields = fields[1:]
+	}
+
+	if len(fields) < 1 || len(fields) > 2 {
+		return fmt.Errorf("invalid preserveAspectRatio: %s", string(text))
+	}
+
+	res.Align = AspectAlign(fields[0])
+	if !res.Align.IsValid() {
+		return fmt.Errorf("invalid preserveAspectRatio align: %s", fields[0])
+	}
+
+	if len(fields) > 1 {
+		res.MeetOrSlice = MeetOrSlice(fields[1])
+		if res.MeetOrSlice != Meet && res.MeetOrSlice != Slice {
+			return fmt.Errorf("invalid preserveAspectRatio meetOrSlice: %s", fields[1])
+		}
+	}
+
+	*par = res
+
+	return nil
+}
+
+// ViewportMatrix returns the Matrix which maps the user space of a ViewBox into the viewport at x, y
+// of the given size, following the algorithm browsers use
+// See: https://www.w3.org/TR/SVG2/coords.html#ComputingAViewportsTransform
+func (vb ViewBox) ViewportMatrix(par PreserveAspectRatio, x, y, width, height float64) (Matrix, error) {
+	if vb.IsEmpty() {
+		return Matrix{}, errors.New("empty viewBox disables rendering")
+	}
+
+	sx, sy := width/vb.Width, height/vb.Height
+
+	align := par.Align
+	if align == "" {
+		align = AlignXMidYMid
+	}
+
+	if align != AlignNone {
+		if par.MeetOrSlice == Slice {
+			sx = math.Max(sx, sy)
+		} else {
+			sx = math.Min(sx, sy)
+		}
+		sy = sx
+	}
+
+	ax, ay := align.offsets()
+
+	tx := x - vb.MinX*sx + (width-vb.Width*sx)*ax
+	ty := y - vb.MinY*sy + (height-vb.Height*sy)*ay
+
+	return Matrix{A: sx, D: sy, E: tx, F: ty}, nil
+}
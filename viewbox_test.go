@@ -0,0 +1,140 @@
+package svg
+
+import (
+	"math"
+	"testing"
+)
+
+func TestViewBox_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    ViewBox
+		wantErr bool
+	}{
+		{"whitespace separated", "0 0 200 100", VB(0, 0, 200, 100), false},
+		{"comma separated", " -10,-5.5, 20 ,1e2 ", VB(-10, -5.5, 20, 100), false},
+		{"too few numbers", "0 0 200", ViewBox{}, true},
+		{"too many numbers", "0 0 200 100 5", ViewBox{}, true},
+		{"negative width", "0 0 -200 100", ViewBox{}, true},
+		{"invalid", "0 0 x 100", ViewBox{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ViewBox
+			if err := got.UnmarshalText([]byte(tt.text)); (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalText() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestViewBox_MarshalText(t *testing.T) {
+	got, err := VB(-1, 0, 20.5, 10).MarshalText()
+	if err != nil || string(got) != "-1 0 20.5 10" {
+		t.Errorf("MarshalText() got = %s, %v, want %s", got, err, "-1 0 20.5 10")
+	}
+
+	if _, err := VB(0, 0, -1, 10).MarshalText(); err == nil {
+		t.Errorf("MarshalText() error = %v, wantErr %v", err, true)
+	}
+}
+
+func TestPreserveAspectRatio_Text(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    PreserveAspectRatio
+		wantErr bool
+	}{
+		{"align", "xMinYMax", PAR(AlignXMinYMax), false},
+		{"none", "none", PAR(AlignNone), false},
+		{"align and slice", "xMidYMid slice", PAR(AlignXMidYMid, Slice), false},
+		{"defer", "defer xMaxYMin meet", PreserveAspectRatio{Defer: true, Align: AlignXMaxYMin, MeetOrSlice: Meet}, false},
+		{"invalid align", "xmidymid", PreserveAspectRatio{}, true},
+		{"invalid meet or slice", "xMidYMid cover", PreserveAspectRatio{}, true},
+		{"empty", "", PreserveAspectRatio{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got PreserveAspectRatio
+			if err := got.UnmarshalText([]byte(tt.text)); (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalText() got = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			text, err := got.MarshalText()
+			if err != nil || string(text) != tt.text {
+				t.Errorf("MarshalText() got = %s, %v, want %s", text, err, tt.text)
+			}
+		})
+	}
+
+	if got := (PreserveAspectRatio{}).String(); got != "xMidYMid" {
+		t.Errorf("String() = %v, want %v", got, "xMidYMid")
+	}
+}
+
+func TestViewBox_ViewportMatrix(t *testing.T) {
+	tests := []struct {
+		name string
+		vb   ViewBox
+		par  PreserveAspectRatio
+		x, y float64
+		want Matrix
+	}{
+		{"default meets in the middle", VB(0, 0, 100, 50), PreserveAspectRatio{}, 0, 0, Matrix{A: 2, D: 2, E: 0, F: 50}},
+		{"min meet", VB(0, 0, 100, 50), PAR(AlignXMinYMin, Meet), 0, 0, Matrix{A: 2, D: 2}},
+		{"max meet", VB(0, 0, 100, 50), PAR(AlignXMaxYMax), 0, 0, Matrix{A: 2, D: 2, F: 100}},
+		{"slice", VB(0, 0, 100, 50), PAR(AlignXMidYMid, Slice), 0, 0, Matrix{A: 4, D: 4, E: -100}},
+		{"none stretches", VB(0, 0, 100, 50), PAR(AlignNone), 0, 0, Matrix{A: 2, D: 4}},
+		{"offset viewBox", VB(10, 20, 200, 200), PAR(AlignXMinYMin), 5, 10, Matrix{A: 1, D: 1, E: -5, F: -10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.vb.ViewportMatrix(tt.par, tt.x, tt.y, 200, 200)
+			if err != nil {
+				t.Fatalf("ViewportMatrix() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ViewportMatrix() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := VB(0, 0, 0, 10).ViewportMatrix(PreserveAspectRatio{}, 0, 0, 10, 10); err == nil {
+		t.Errorf("ViewportMatrix() error = %v, wantErr %v", err, true)
+	}
+}
+
+func TestSVG_ViewportMatrix(t *testing.T) {
+	lr := NewLengthResolver(800, 600)
+
+	s := NewSVG(0, 0).SetWidth(Lth(50, Percent)).SetHeight(Lth(3.125, In)).SetViewBox(VB(0, 0, 40, 30))
+
+	w, h := s.ViewportSize(lr)
+	if w != 400 || h != 300 {
+		t.Errorf("ViewportSize() = %v, %v, want 400, 300", w, h)
+	}
+
+	m, err := s.ViewportMatrix(lr)
+	if err != nil {
+		t.Fatalf("ViewportMatrix() error = %v", err)
+	}
+	if x, y := m.Apply(40, 30); math.Abs(x-400) > 1e-9 || math.Abs(y-300) > 1e-9 {
+		t.Errorf("ViewportMatrix().Apply() = %v, %v, want 400, 300", x, y)
+	}
+
+	m, err = s.UnsetViewBox().ViewportMatrix(lr)
+	if err != nil || m != IdentityMatrix() {
+		t.Errorf("ViewportMatrix() = %v, %v, want identity", m, err)
+	}
+}